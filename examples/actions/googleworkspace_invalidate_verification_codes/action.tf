@@ -0,0 +1,5 @@
+action "googleworkspace_invalidate_verification_codes" "jdoe" {
+  config {
+    user_key = "jdoe@example.com"
+  }
+}
@@ -0,0 +1,9 @@
+action "googleworkspace_mobile_device_action" "wipe_account" {
+  config {
+    resource_id = "AFiQxQ8Qgd-rHx6fJMKPR5G4wUszkgF3bjuSg7gqSqo6Ig"
+    action      = "admin_account_wipe"
+
+    # Wiping cannot be undone.
+    confirm = true
+  }
+}
@@ -0,0 +1,10 @@
+data "googleworkspace_asps" "jdoe" {
+  user_key = "jdoe@example.com"
+}
+
+action "googleworkspace_revoke_asp" "jdoe" {
+  config {
+    user_key = "jdoe@example.com"
+    code_id  = data.googleworkspace_asps.jdoe.asps[0].code_id
+  }
+}
@@ -0,0 +1,5 @@
+action "googleworkspace_signout_user" "jdoe" {
+  config {
+    user_key = "jdoe@example.com"
+  }
+}
@@ -0,0 +1,5 @@
+action "googleworkspace_suspend_user" "offboard" {
+  config {
+    user_key = "jdoe@example.com"
+  }
+}
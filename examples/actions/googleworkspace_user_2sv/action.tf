@@ -0,0 +1,6 @@
+action "googleworkspace_user_2sv" "jdoe" {
+  config {
+    user_key = "jdoe@example.com"
+    enabled  = false
+  }
+}
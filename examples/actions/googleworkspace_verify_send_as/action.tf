@@ -0,0 +1,6 @@
+action "googleworkspace_verify_send_as" "support" {
+  config {
+    user_id       = "jdoe@example.com"
+    send_as_email = "support@example.org"
+  }
+}
@@ -0,0 +1,4 @@
+# The application-specific passwords of a user.
+data "googleworkspace_asps" "jdoe" {
+  user_key = "jdoe@example.com"
+}
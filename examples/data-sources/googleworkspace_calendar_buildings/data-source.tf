@@ -0,0 +1 @@
+data "googleworkspace_calendar_buildings" "all" {}
@@ -0,0 +1,3 @@
+data "googleworkspace_calendar_resources" "rooms" {
+  query = "resourceCategory=CONFERENCE_ROOM"
+}
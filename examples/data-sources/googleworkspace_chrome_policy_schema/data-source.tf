@@ -0,0 +1,3 @@
+data "googleworkspace_chrome_policy_schema" "max_connections" {
+  schema_name = "chrome.users.MaxConnectionsPerProxy"
+}
@@ -0,0 +1,3 @@
+data "googleworkspace_chrome_policy_schemas" "users" {
+  filter = "name=customers/my_customer/policySchemas/chrome.users.*"
+}
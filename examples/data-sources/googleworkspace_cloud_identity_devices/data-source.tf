@@ -0,0 +1,3 @@
+data "googleworkspace_cloud_identity_devices" "android" {
+  filter = "type:android"
+}
@@ -0,0 +1,4 @@
+data "googleworkspace_cloud_identity_policy" "takeout" {
+  customer = "customers/C01abc23d"
+  name     = "policies/akajj264aovytg7aau"
+}
@@ -0,0 +1,5 @@
+data "googleworkspace_customer" "current" {}
+
+output "primary_domain" {
+  value = data.googleworkspace_customer.current.customer_domain
+}
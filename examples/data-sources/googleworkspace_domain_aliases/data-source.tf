@@ -0,0 +1,3 @@
+data "googleworkspace_domain_aliases" "primary" {
+  parent_domain_name = "example.com"
+}
@@ -0,0 +1,4 @@
+data "googleworkspace_gmail_send_as" "support" {
+  user_id       = "jdoe@example.com"
+  send_as_email = "support@example.com"
+}
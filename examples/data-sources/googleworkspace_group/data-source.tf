@@ -0,0 +1,7 @@
+data "googleworkspace_group" "engineering" {
+  email = "engineering@example.com"
+}
+
+output "engineering_group_id" {
+  value = data.googleworkspace_group.engineering.id
+}
@@ -0,0 +1,4 @@
+# The members of the group, including those of its nested groups.
+data "googleworkspace_group_members_expanded" "engineering" {
+  group_key = "engineering@example.com"
+}
@@ -0,0 +1,10 @@
+data "googleworkspace_group_settings" "support" {
+  email = "support@example.com"
+
+  # Return Google's defaults for the settings it leaves empty.
+  merge_with_defaults = true
+}
+
+output "who_can_post" {
+  value = data.googleworkspace_group_settings.support.who_can_post_message
+}
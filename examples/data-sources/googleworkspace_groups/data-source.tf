@@ -0,0 +1,7 @@
+data "googleworkspace_groups" "all" {
+  domain = "example.com"
+}
+
+output "group_emails" {
+  value = data.googleworkspace_groups.all.groups[*].email
+}
@@ -0,0 +1,3 @@
+data "googleworkspace_license_assignments" "workspace" {
+  product_id = "Google-Apps"
+}
@@ -0,0 +1,4 @@
+data "googleworkspace_org_units" "engineering" {
+  org_unit_path = "/Engineering"
+  type          = "children"
+}
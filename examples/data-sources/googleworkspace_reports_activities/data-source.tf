@@ -0,0 +1,6 @@
+# Changes to group settings made by admins since the start of the year.
+data "googleworkspace_reports_activities" "group_settings" {
+  application_name = "admin"
+  event_name       = "CHANGE_GROUP_SETTING"
+  start_time       = "2025-01-01T00:00:00Z"
+}
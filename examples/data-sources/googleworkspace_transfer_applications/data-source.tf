@@ -0,0 +1 @@
+data "googleworkspace_transfer_applications" "all" {}
@@ -0,0 +1,3 @@
+data "googleworkspace_user_security_settings" "jdoe" {
+  user_key = "jdoe@example.com"
+}
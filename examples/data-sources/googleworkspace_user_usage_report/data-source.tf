@@ -0,0 +1,5 @@
+data "googleworkspace_user_usage_report" "jdoe" {
+  user_key   = "jdoe@example.com"
+  date       = "2025-01-31"
+  parameters = ["accounts:last_login_time", "gmail:num_emails_sent"]
+}
@@ -0,0 +1,7 @@
+data "googleworkspace_users" "engineering" {
+  org_unit_path = "/Engineering"
+}
+
+output "engineers" {
+  value = data.googleworkspace_users.engineering.users[*].primary_email
+}
@@ -1,3 +0,0 @@
-data "group" "example" {
-  configurable_attribute = "some-value"
-}
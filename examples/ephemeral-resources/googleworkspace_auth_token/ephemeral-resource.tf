@@ -0,0 +1,5 @@
+# An access token of the impersonated user, e.g. for a provider or tool
+# calling a Google API that this provider doesn't cover.
+ephemeral "googleworkspace_auth_token" "directory" {
+  scopes = ["https://www.googleapis.com/auth/admin.directory.user.readonly"]
+}
@@ -0,0 +1,16 @@
+ephemeral "googleworkspace_password" "initial" {
+  length      = 20
+  min_digits  = 2
+  min_symbols = 2
+}
+
+resource "googleworkspace_user" "jdoe" {
+  primary_email = "jdoe@example.com"
+  name = {
+    given_name  = "John"
+    family_name = "Doe"
+  }
+
+  password                      = ephemeral.googleworkspace_password.initial.value
+  change_password_at_next_login = true
+}
@@ -0,0 +1,5 @@
+# The backup verification codes of a user, e.g. to hand them over through a
+# secrets manager.
+ephemeral "googleworkspace_verification_codes" "jdoe" {
+  user_key = "jdoe@example.com"
+}
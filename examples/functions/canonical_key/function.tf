@@ -0,0 +1,4 @@
+# Returns "engineering@example.com".
+output "group_key" {
+  value = provider::googleworkspace::canonical_key("Engineering@Example.com")
+}
@@ -0,0 +1,4 @@
+# Returns "entity.org_units.exists(org_unit, org_unit.org_unit_id == orgUnitId('03ph8a2z1'))".
+output "policy_query" {
+  value = provider::googleworkspace::cel_policy_query("03ph8a2z1", null, null)
+}
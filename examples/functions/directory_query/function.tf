@@ -0,0 +1,3 @@
+data "googleworkspace_users" "engineering" {
+  query = provider::googleworkspace::directory_query("orgUnitPath", "=", "/Engineering")
+}
@@ -0,0 +1,4 @@
+# Returns "jdoe@example.com".
+output "email" {
+  value = provider::googleworkspace::normalize_email(" JDoe@Example.COM ")
+}
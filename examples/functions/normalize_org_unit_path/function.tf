@@ -0,0 +1,4 @@
+# Returns "/Engineering/Backend".
+output "org_unit_path" {
+  value = provider::googleworkspace::normalize_org_unit_path("Engineering/Backend/")
+}
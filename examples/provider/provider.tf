@@ -1,3 +1,19 @@
 provider "googleworkspace" {
-  # example configuration here
+  # A service account key with domain-wide delegation, or omit it to use
+  # Application Default Credentials.
+  credentials             = file("service-account.json")
+  impersonated_user_email = "admin@example.com"
+  customer_id             = "C01abc23d"
+}
+
+# oauth_scopes replaces the default scopes, e.g. to also manage domains.
+provider "googleworkspace" {
+  alias                   = "domains"
+  impersonated_user_email = "admin@example.com"
+  oauth_scopes = [
+    "https://www.googleapis.com/auth/admin.directory.group",
+    "https://www.googleapis.com/auth/admin.directory.user",
+    "https://www.googleapis.com/auth/cloud-identity.policies",
+    "https://www.googleapis.com/auth/admin.directory.domain",
+  ]
 }
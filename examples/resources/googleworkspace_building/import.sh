@@ -0,0 +1,2 @@
+# A building is imported by its building ID.
+terraform import googleworkspace_building.hq hq
@@ -0,0 +1,11 @@
+resource "googleworkspace_building" "hq" {
+  building_id   = "hq"
+  building_name = "Headquarters"
+  description   = "Main office"
+  floor_names   = ["G", "1", "2"]
+
+  coordinates = {
+    latitude  = 52.3676
+    longitude = 4.9041
+  }
+}
@@ -0,0 +1,2 @@
+# A ChromeOS device is imported by its device ID.
+terraform import googleworkspace_chrome_os_device.kiosk a1b2c3d4-e5f6-7890-abcd-ef1234567890
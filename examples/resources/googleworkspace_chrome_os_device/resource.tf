@@ -0,0 +1,5 @@
+resource "googleworkspace_chrome_os_device" "kiosk" {
+  device_id     = "a1b2c3d4-e5f6-7890-abcd-ef1234567890"
+  org_unit_path = "/Kiosks"
+  status        = "ACTIVE"
+}
@@ -0,0 +1,2 @@
+# A policy is imported by the org unit ID and the policy schema.
+terraform import googleworkspace_chrome_policy.max_connections 03ph8a2z1/chrome.users.MaxConnectionsPerProxy
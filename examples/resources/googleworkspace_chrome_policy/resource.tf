@@ -0,0 +1,7 @@
+resource "googleworkspace_chrome_policy" "max_connections" {
+  org_unit_id   = "03ph8a2z1"
+  policy_schema = "chrome.users.MaxConnectionsPerProxy"
+  value = jsonencode({
+    maxConnectionsPerProxy = 32
+  })
+}
@@ -0,0 +1,2 @@
+# A group is imported by its resource name.
+terraform import googleworkspace_cloud_identity_group.engineering groups/01234567890abcd
@@ -0,0 +1,21 @@
+resource "googleworkspace_cloud_identity_group" "engineering" {
+  parent       = "customers/C01abc23d"
+  group_key    = "engineering@example.com"
+  display_name = "Engineering"
+  description  = "All engineers"
+}
+
+# A dynamic group whose members are the users matching the query.
+resource "googleworkspace_cloud_identity_group" "sales" {
+  parent       = "customers/C01abc23d"
+  group_key    = "sales-everyone@example.com"
+  display_name = "Sales (everyone)"
+
+  dynamic_group_metadata = {
+    queries = [
+      {
+        query = "user.organizations.exists(org, org.department=='Sales')"
+      },
+    ]
+  }
+}
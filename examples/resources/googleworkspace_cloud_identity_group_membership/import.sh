@@ -0,0 +1,2 @@
+# A membership is imported by its resource name.
+terraform import googleworkspace_cloud_identity_group_membership.jdoe groups/01234567890abcd/memberships/123456789012345678901
@@ -0,0 +1,5 @@
+resource "googleworkspace_cloud_identity_group_membership" "jdoe" {
+  group      = googleworkspace_cloud_identity_group.engineering.name
+  member_key = "jdoe@example.com"
+  roles      = ["MEMBER", "MANAGER"]
+}
@@ -0,0 +1,2 @@
+# A policy is imported by its resource name.
+terraform import googleworkspace_cloud_identity_policy.takeout policies/akajj264aovytg7aau
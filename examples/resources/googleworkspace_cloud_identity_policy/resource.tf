@@ -0,0 +1,9 @@
+resource "googleworkspace_cloud_identity_policy" "takeout" {
+  name = "policies/akajj264aovytg7aau"
+
+  setting = {
+    value = jsonencode({
+      takeoutStatus = "DISABLED"
+    })
+  }
+}
@@ -0,0 +1,2 @@
+# A transfer is imported by its unique ID.
+terraform import googleworkspace_data_transfer.jdoe AKrEtIYG88WDx9sC2sjK8pbp4F9jUO4kv_wV8Eo8uG3xOhSDqIuRaZlLS2ThTg
@@ -0,0 +1,19 @@
+data "googleworkspace_transfer_applications" "all" {}
+
+# Transfer the Drive files of a departing user to their manager.
+resource "googleworkspace_data_transfer" "jdoe" {
+  old_owner_user_id = "123456789012345678901"
+  new_owner_user_id = "109876543210987654321"
+
+  application_data_transfers = [
+    {
+      application_id = one([for a in data.googleworkspace_transfer_applications.all.applications : a.id if a.name == "Drive and Docs"])
+      params = [
+        {
+          key    = "PRIVACY_LEVEL"
+          values = ["PRIVATE", "SHARED"]
+        },
+      ]
+    },
+  ]
+}
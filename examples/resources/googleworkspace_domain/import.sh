@@ -0,0 +1,2 @@
+# A domain is imported by its name.
+terraform import googleworkspace_domain.secondary example.org
@@ -0,0 +1,3 @@
+resource "googleworkspace_domain" "secondary" {
+  domain_name = "example.org"
+}
@@ -0,0 +1,2 @@
+# A feature is imported by its name.
+terraform import googleworkspace_feature.video_conferencing "Video conferencing"
@@ -0,0 +1,3 @@
+resource "googleworkspace_feature" "video_conferencing" {
+  name = "Video conferencing"
+}
@@ -0,0 +1,2 @@
+# Auto-forwarding is imported by the email address of the user.
+terraform import googleworkspace_gmail_auto_forwarding.jdoe jdoe@example.com
@@ -0,0 +1,10 @@
+resource "googleworkspace_gmail_forwarding_address" "archive" {
+  user_id          = "jdoe@example.com"
+  forwarding_email = "archive@example.com"
+}
+
+resource "googleworkspace_gmail_auto_forwarding" "jdoe" {
+  user_id       = googleworkspace_gmail_forwarding_address.archive.user_id
+  email_address = googleworkspace_gmail_forwarding_address.archive.forwarding_email
+  disposition   = "archive"
+}
@@ -0,0 +1,2 @@
+# A filter is imported by the user and the filter ID.
+terraform import googleworkspace_gmail_filter.invoices jdoe@example.com/ANe1Bmj1234
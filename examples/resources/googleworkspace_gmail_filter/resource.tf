@@ -0,0 +1,13 @@
+resource "googleworkspace_gmail_filter" "invoices" {
+  user_id = "jdoe@example.com"
+
+  criteria = {
+    from           = "billing@vendor.example"
+    has_attachment = true
+  }
+
+  action = {
+    add_label_ids    = ["Label_1"]
+    remove_label_ids = ["INBOX"]
+  }
+}
@@ -0,0 +1,2 @@
+# A forwarding address is imported by the user and the forwarding address.
+terraform import googleworkspace_gmail_forwarding_address.archive jdoe@example.com/archive@example.com
@@ -0,0 +1,4 @@
+resource "googleworkspace_gmail_forwarding_address" "archive" {
+  user_id          = "jdoe@example.com"
+  forwarding_email = "archive@example.com"
+}
@@ -0,0 +1,2 @@
+# The IMAP and POP settings are imported by the email address of the user.
+terraform import googleworkspace_gmail_imap_pop.jdoe jdoe@example.com
@@ -0,0 +1,5 @@
+resource "googleworkspace_gmail_imap_pop" "jdoe" {
+  user_id      = "jdoe@example.com"
+  imap_enabled = false
+  pop_enabled  = false
+}
@@ -0,0 +1,2 @@
+# The vacation responder is imported by the email address of the user.
+terraform import googleworkspace_gmail_vacation.jdoe jdoe@example.com
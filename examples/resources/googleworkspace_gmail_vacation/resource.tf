@@ -0,0 +1,8 @@
+resource "googleworkspace_gmail_vacation" "jdoe" {
+  user_id            = "jdoe@example.com"
+  response_subject   = "Out of office"
+  response_body_html = "<p>I'm out of the office until July 15th.</p>"
+  start_time         = "2025-07-01T00:00:00Z"
+  end_time           = "2025-07-15T00:00:00Z"
+  restrict_to_domain = true
+}
@@ -0,0 +1,3 @@
+# A group can be imported by its unique ID or its email address.
+terraform import googleworkspace_group.engineering 01234567890abcd
+terraform import googleworkspace_group.engineering engineering@example.com
@@ -0,0 +1,17 @@
+resource "googleworkspace_group" "engineering" {
+  email       = "engineering@example.com"
+  name        = "Engineering"
+  description = "All engineers"
+
+  aliases = ["eng@example.com"]
+
+  members = [
+    {
+      email = "jdoe@example.com"
+      role  = "OWNER"
+    },
+    {
+      email = "asmith@example.com"
+    },
+  ]
+}
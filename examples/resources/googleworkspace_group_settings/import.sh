@@ -0,0 +1,2 @@
+# Group settings are imported by the email address of the group.
+terraform import googleworkspace_group_settings.support support@example.com
@@ -0,0 +1,15 @@
+resource "googleworkspace_group" "support" {
+  email = "support@example.com"
+  name  = "Support"
+}
+
+resource "googleworkspace_group_settings" "support" {
+  email = googleworkspace_group.support.group_key
+
+  who_can_join             = "INVITED_CAN_JOIN"
+  who_can_post_message     = "ANYONE_CAN_POST"
+  allow_external_members   = false
+  message_moderation_level = "MODERATE_NONE"
+  spam_moderation_level    = "MODERATE"
+  is_archived              = true
+}
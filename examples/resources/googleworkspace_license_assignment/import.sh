@@ -0,0 +1,2 @@
+# A license assignment is imported by the product ID, the SKU ID and the user.
+terraform import googleworkspace_license_assignment.jdoe Google-Apps/1010020027/jdoe@example.com
@@ -0,0 +1,5 @@
+resource "googleworkspace_license_assignment" "jdoe" {
+  product_id = "Google-Apps"
+  sku_id     = "1010020027"
+  user_id    = "jdoe@example.com"
+}
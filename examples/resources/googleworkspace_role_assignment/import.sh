@@ -0,0 +1,2 @@
+# A role assignment is imported by its unique ID.
+terraform import googleworkspace_role_assignment.helpdesk 9876543210987654
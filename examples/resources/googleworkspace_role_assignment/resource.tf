@@ -0,0 +1,13 @@
+data "googleworkspace_users" "helpdesk" {
+  query = "orgUnitPath='/Helpdesk'"
+}
+
+# Grant the Help Desk Admin role, restricted to the Engineering org unit.
+resource "googleworkspace_role_assignment" "helpdesk" {
+  for_each = { for u in data.googleworkspace_users.helpdesk.users : u.primary_email => u.id }
+
+  role_id     = "01234567890123456"
+  assigned_to = each.value
+  scope_type  = "ORG_UNIT"
+  org_unit_id = "03ph8a2z1"
+}
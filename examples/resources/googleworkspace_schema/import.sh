@@ -0,0 +1,2 @@
+# A schema is imported by its unique ID.
+terraform import googleworkspace_schema.employment AbCdEfGhIjKlMnOp
@@ -0,0 +1,17 @@
+resource "googleworkspace_schema" "employment" {
+  schema_name  = "employment"
+  display_name = "Employment"
+
+  fields = [
+    {
+      field_name = "employee_number"
+      field_type = "STRING"
+    },
+    {
+      field_name       = "cost_centers"
+      field_type       = "STRING"
+      multi_valued     = true
+      read_access_type = "ADMINS_AND_SELF"
+    },
+  ]
+}
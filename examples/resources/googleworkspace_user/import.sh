@@ -0,0 +1,3 @@
+# A user can be imported by its unique ID or its primary email address.
+terraform import googleworkspace_user.jdoe 123456789012345678901
+terraform import googleworkspace_user.jdoe jdoe@example.com
@@ -0,0 +1,28 @@
+ephemeral "googleworkspace_password" "jdoe" {
+  length = 20
+}
+
+resource "googleworkspace_user" "jdoe" {
+  primary_email = "jdoe@example.com"
+  name = {
+    given_name  = "John"
+    family_name = "Doe"
+  }
+  org_unit_path = "/Engineering"
+
+  # The password is write-only, it is only sent to Google on create and
+  # whenever password_version changes.
+  password                      = ephemeral.googleworkspace_password.jdoe.value
+  password_version              = 1
+  change_password_at_next_login = true
+
+  work_phone = "+15555550100"
+
+  organizations = [
+    {
+      title      = "Software Engineer"
+      department = "Engineering"
+      primary    = true
+    },
+  ]
+}
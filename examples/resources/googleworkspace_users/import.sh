@@ -0,0 +1,2 @@
+# The users of an org unit are imported by the org unit path.
+terraform import googleworkspace_users.engineering /Engineering
@@ -0,0 +1,26 @@
+variable "initial_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "googleworkspace_users" "engineering" {
+  org_unit_path = "/Engineering"
+
+  # New users must change the password at their first login.
+  initial_password = var.initial_password
+
+  users = [
+    {
+      primary_email = "jdoe@example.com"
+      given_name    = "John"
+      family_name   = "Doe"
+    },
+    {
+      primary_email = "asmith@example.com"
+      given_name    = "Alice"
+      family_name   = "Smith"
+      suspended     = true
+    },
+  ]
+}
@@ -1 +0,0 @@
-terraform import scaffolding_example.test "id-123"
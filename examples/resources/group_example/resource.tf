@@ -1,3 +0,0 @@
-resource "group" "example" {
-  configurable_attribute = "some-value"
-}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+)
+
+// wwwAuthenticateScope extracts the scope a request was missing from the
+// WWW-Authenticate header of an insufficient_scope response.
+var wwwAuthenticateScope = regexp.MustCompile(`scope="([^"]*)"`)
+
+// formatAPIError formats err for diagnostics. Google API errors are reduced
+// to their HTTP status, first error reason, message and error details, e.g.
+// "HTTP 403 (insufficientPermissions): Request had insufficient
+// authentication scopes. Missing scope: ...". Other errors are formatted as
+// is.
+func formatAPIError(err error) string {
+	var googleErr *googleapi.Error
+	if !errors.As(err, &googleErr) {
+		return err.Error()
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "HTTP %d", googleErr.Code)
+
+	message := googleErr.Message
+	if len(googleErr.Errors) > 0 {
+		if reason := googleErr.Errors[0].Reason; reason != "" {
+			fmt.Fprintf(&b, " (%s)", reason)
+		}
+		if message == "" {
+			message = googleErr.Errors[0].Message
+		}
+	}
+
+	switch {
+	case message != "":
+		fmt.Fprintf(&b, ": %s", message)
+	case googleErr.Body != "":
+		fmt.Fprintf(&b, ": %s", strings.TrimSpace(googleErr.Body))
+	}
+
+	if m := wwwAuthenticateScope.FindStringSubmatch(googleErr.Header.Get("WWW-Authenticate")); m != nil {
+		fmt.Fprintf(&b, ". Missing scope: %s", m[1])
+	}
+
+	for _, detail := range googleErr.Details {
+		d, err := json.Marshal(detail)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&b, "\nDetail: %s", d)
+	}
+
+	return b.String()
+}
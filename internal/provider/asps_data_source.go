@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AspsDataSource{}
+
+func NewAspsDataSource() datasource.DataSource {
+	return &AspsDataSource{}
+}
+
+// AspsDataSource defines the data source implementation.
+type AspsDataSource struct {
+	client *http.Client
+
+	adminService *admin.Service
+}
+
+// AspsDataSourceModel describes the data source data model.
+type AspsDataSourceModel struct {
+	UserKey types.String        `tfsdk:"user_key"`
+	Asps    []AspsDataSourceAsp `tfsdk:"asps"`
+	Id      types.String        `tfsdk:"id"`
+}
+
+// Nested Model for "asps".
+type AspsDataSourceAsp struct {
+	CodeId       types.Int64  `tfsdk:"code_id"`
+	Name         types.String `tfsdk:"name"`
+	CreationTime types.String `tfsdk:"creation_time"`
+	LastTimeUsed types.String `tfsdk:"last_time_used"`
+}
+
+func (d *AspsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asps"
+}
+
+func (d *AspsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Lists the application-specific passwords (ASPs) of a user. ASPs can be
+		revoked with the googleworkspace_revoke_asp action.` + scopesDescription(admin.AdminDirectoryUserSecurityScope),
+
+		Attributes: map[string]schema.Attribute{
+			"user_key": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address, alias email address, or unique user ID",
+				Required:            true,
+			},
+			"asps": schema.ListNestedAttribute{
+				MarkdownDescription: "The ASPs of the user sorted by code_id, empty when the user has none",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code_id": schema.Int64Attribute{
+							MarkdownDescription: "Identifier of the ASP",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the application the ASP was created for",
+							Computed:            true,
+						},
+						"creation_time": schema.StringAttribute{
+							MarkdownDescription: "The time the ASP was created, in RFC 3339 format",
+							Computed:            true,
+						},
+						"last_time_used": schema.StringAttribute{
+							MarkdownDescription: "The time the ASP was last used, in RFC 3339 format, empty if never used",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AspsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.adminService = pd.adminService
+}
+
+func (d *AspsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AspsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userKey := data.UserKey.ValueString()
+
+	res, err := d.adminService.Asps.List(userKey).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list ASPs of user '%s', got error: %s", userKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	// The API omits the list entirely when the user has no ASPs.
+	data.Asps = make([]AspsDataSourceAsp, 0, len(res.Items))
+	for _, a := range res.Items {
+		data.Asps = append(data.Asps, flattenAsp(a))
+	}
+
+	sortByKey(data.Asps, func(a AspsDataSourceAsp) int64 { return a.CodeId.ValueInt64() })
+
+	data.Id = types.StringValue(userKey)
+
+	tflog.Trace(ctx, "read ASPs", map[string]interface{}{
+		"user_key": userKey,
+		"count":    len(data.Asps),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenAsp(a *admin.Asp) AspsDataSourceAsp {
+	lastTimeUsed := ""
+	if a.LastTimeUsed != 0 {
+		lastTimeUsed = time.UnixMilli(a.LastTimeUsed).UTC().Format(time.RFC3339)
+	}
+
+	return AspsDataSourceAsp{
+		CodeId:       types.Int64Value(a.CodeId),
+		Name:         types.StringValue(a.Name),
+		CreationTime: types.StringValue(time.UnixMilli(a.CreationTime).UTC().Format(time.RFC3339)),
+		LastTimeUsed: types.StringValue(lastTimeUsed),
+	}
+}
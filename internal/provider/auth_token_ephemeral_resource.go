@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &AuthTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AuthTokenEphemeralResource{}
+
+func NewAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AuthTokenEphemeralResource{}
+}
+
+// AuthTokenEphemeralResource defines the ephemeral resource implementation.
+type AuthTokenEphemeralResource struct {
+	data *ephemeralResourceData
+}
+
+// AuthTokenEphemeralResourceModel describes the ephemeral resource data model.
+type AuthTokenEphemeralResourceModel struct {
+	Scopes      types.List   `tfsdk:"scopes"`
+	AccessToken types.String `tfsdk:"access_token"`
+	Expiry      types.String `tfsdk:"expiry"`
+}
+
+func (r *AuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_token"
+}
+
+func (r *AuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Short-lived OAuth access token acting as the impersonated user, minted
+		from the provider credentials. The token is never stored in the plan or state.`,
+
+		Attributes: map[string]schema.Attribute{
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: `OAuth scopes to request instead of the provider scopes. Every scope
+				must be granted to the service account in the domain-wide delegation settings.
+				Cannot be used with the access_token provider attribute.`,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The OAuth access token",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expiry": schema.StringAttribute{
+				MarkdownDescription: "Expiry of the access token in RFC3339 format, empty if unknown",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AuthTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ephemeralResourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ephemeralResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.data = data
+}
+
+func (r *AuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AuthTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ts := r.data.tokenSource
+	if !data.Scopes.IsNull() {
+		if r.data.newTokenSource == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("scopes"),
+				"Scopes Not Supported",
+				"The scopes of a static access_token configured on the provider cannot be changed.",
+			)
+			return
+		}
+
+		var scopes []string
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		ts, err = r.data.newTokenSource(ctx, "", scopes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to configure Google credentials",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	token, err := ts.Token()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Minting Access Token",
+			fmt.Sprintf("Could not mint an access token: %v", err),
+		)
+		return
+	}
+
+	flattenAuthToken(token, &data)
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func flattenAuthToken(token *oauth2.Token, data *AuthTokenEphemeralResourceModel) {
+	data.AccessToken = types.StringValue(token.AccessToken)
+
+	// Static tokens have no known expiry.
+	data.Expiry = types.StringValue("")
+	if !token.Expiry.IsZero() {
+		data.Expiry = types.StringValue(token.Expiry.UTC().Format(time.RFC3339))
+	}
+}
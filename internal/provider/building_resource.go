@@ -0,0 +1,311 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BuildingResource{}
+var _ resource.ResourceWithImportState = &BuildingResource{}
+
+func NewBuildingResource() resource.Resource {
+	return &BuildingResource{}
+}
+
+// BuildingResource defines the resource implementation.
+type BuildingResource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// BuildingResourceModel describes the resource data model.
+type BuildingResourceModel struct {
+	BuildingId   types.String              `tfsdk:"building_id"`
+	BuildingName types.String              `tfsdk:"building_name"`
+	Description  types.String              `tfsdk:"description"`
+	FloorNames   types.List                `tfsdk:"floor_names"`
+	Coordinates  *BuildingCoordinatesModel `tfsdk:"coordinates"`
+	Etag         types.String              `tfsdk:"etag"`
+	Id           types.String              `tfsdk:"id"`
+}
+
+// Nested Model for "coordinates".
+type BuildingCoordinatesModel struct {
+	Latitude  types.Float64 `tfsdk:"latitude"`
+	Longitude types.Float64 `tfsdk:"longitude"`
+}
+
+func (r *BuildingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_building"
+}
+
+func (r *BuildingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Calendar building resource" + scopesDescription(admin.AdminDirectoryResourceCalendarScope),
+
+		Attributes: map[string]schema.Attribute{
+			"building_id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the building",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"building_name": schema.StringAttribute{
+				MarkdownDescription: "The building name as seen by users in Calendar",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A brief description of the building",
+				Optional:            true,
+			},
+			"floor_names": schema.ListAttribute{
+				MarkdownDescription: "The display names of the building's floors, ordered from lowest to highest",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"coordinates": schema.SingleNestedAttribute{
+				MarkdownDescription: "The geographic coordinates of the center of the building",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"latitude": schema.Float64Attribute{
+						MarkdownDescription: "Latitude in decimal degrees",
+						Required:            true,
+					},
+					"longitude": schema.Float64Attribute{
+						MarkdownDescription: "Longitude in decimal degrees",
+						Required:            true,
+					},
+				},
+			},
+			"etag": schema.StringAttribute{
+				MarkdownDescription: "ETag of the building",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Building identifier, same as building_id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BuildingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.customerId = pd.customerId
+	r.adminService = pd.adminService
+}
+
+func (r *BuildingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BuildingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	building, diags := expandBuilding(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Resources.Buildings.Insert(r.customerId, building).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Building",
+			fmt.Sprintf("Could not create building %s: %v", data.BuildingId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenBuilding(ctx, res, &data)...)
+
+	tflog.Trace(ctx, "Created Building", map[string]interface{}{
+		"building_id": res.BuildingId,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BuildingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BuildingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Resources.Buildings.Get(r.customerId, data.BuildingId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Building no longer exists in Google Workspace, removing from state", map[string]interface{}{
+				"building_id": data.BuildingId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read building '%s', got error: %s", data.BuildingId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenBuilding(ctx, res, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BuildingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BuildingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	building, diags := expandBuilding(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Resources.Buildings.Update(r.customerId, data.BuildingId.ValueString(), building).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Building",
+			fmt.Sprintf("Could not update building %s: %v", data.BuildingId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenBuilding(ctx, res, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BuildingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BuildingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.adminService.Resources.Buildings.Delete(r.customerId, data.BuildingId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "Building already deleted in Google Workspace", map[string]interface{}{
+				"building_id": data.BuildingId.ValueString(),
+			})
+			return
+		}
+
+		// Google explains why, e.g. when rooms are still assigned to the
+		// building, so pass its error through.
+		resp.Diagnostics.AddError(
+			"Error Deleting Building",
+			fmt.Sprintf("Could not delete building %s: %v", data.BuildingId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *BuildingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("building_id"), req, resp)
+}
+
+func expandBuilding(ctx context.Context, data *BuildingResourceModel) (*admin.Building, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b := &admin.Building{
+		BuildingId:   data.BuildingId.ValueString(),
+		BuildingName: data.BuildingName.ValueString(),
+		Description:  data.Description.ValueString(),
+	}
+	diags.Append(data.FloorNames.ElementsAs(ctx, &b.FloorNames, false)...)
+
+	if data.Coordinates != nil {
+		b.Coordinates = &admin.BuildingCoordinates{
+			Latitude:  data.Coordinates.Latitude.ValueFloat64(),
+			Longitude: data.Coordinates.Longitude.ValueFloat64(),
+		}
+	}
+
+	return b, diags
+}
+
+func flattenBuilding(ctx context.Context, b *admin.Building, data *BuildingResourceModel) diag.Diagnostics {
+	data.Id = types.StringValue(b.BuildingId)
+	data.BuildingId = types.StringValue(b.BuildingId)
+	data.BuildingName = types.StringValue(b.BuildingName)
+	data.Etag = types.StringValue(b.Etags)
+
+	// Keep an unset description null rather than an empty string.
+	if b.Description != "" || !data.Description.IsNull() {
+		data.Description = types.StringValue(b.Description)
+	}
+
+	floorNames, diags := types.ListValueFrom(ctx, types.StringType, b.FloorNames)
+	data.FloorNames = floorNames
+
+	data.Coordinates = nil
+	if b.Coordinates != nil {
+		data.Coordinates = &BuildingCoordinatesModel{
+			Latitude:  types.Float64Value(b.Coordinates.Latitude),
+			Longitude: types.Float64Value(b.Coordinates.Longitude),
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CalendarBuildingsDataSource{}
+
+func NewCalendarBuildingsDataSource() datasource.DataSource {
+	return &CalendarBuildingsDataSource{}
+}
+
+// CalendarBuildingsDataSource defines the data source implementation.
+type CalendarBuildingsDataSource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// CalendarBuildingsDataSourceModel describes the data source data model.
+type CalendarBuildingsDataSourceModel struct {
+	Customer  types.String            `tfsdk:"customer"`
+	Buildings []CalendarBuildingModel `tfsdk:"buildings"`
+	Id        types.String            `tfsdk:"id"`
+}
+
+// Nested Model for "buildings".
+type CalendarBuildingModel struct {
+	BuildingId   types.String   `tfsdk:"building_id"`
+	BuildingName types.String   `tfsdk:"building_name"`
+	Description  types.String   `tfsdk:"description"`
+	FloorNames   []types.String `tfsdk:"floor_names"`
+	Latitude     types.Float64  `tfsdk:"latitude"`
+	Longitude    types.Float64  `tfsdk:"longitude"`
+}
+
+func (d *CalendarBuildingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_calendar_buildings"
+}
+
+func (d *CalendarBuildingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists all Calendar buildings of a customer" + scopesDescription(admin.AdminDirectoryResourceCalendarScope),
+
+		Attributes: map[string]schema.Attribute{
+			"customer": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the customer's Google Workspace account. Defaults to the provider `customer_id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"buildings": schema.ListNestedAttribute{
+				MarkdownDescription: "The buildings of the customer, sorted by building_id",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"building_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier for the building",
+							Computed:            true,
+						},
+						"building_name": schema.StringAttribute{
+							MarkdownDescription: "The building name as seen by users in Calendar",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A brief description of the building",
+							Computed:            true,
+						},
+						"floor_names": schema.ListAttribute{
+							MarkdownDescription: "The display names for all floors in this building, ordered from lowest to highest",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"latitude": schema.Float64Attribute{
+							MarkdownDescription: "Latitude of the building in decimal degrees",
+							Computed:            true,
+						},
+						"longitude": schema.Float64Attribute{
+							MarkdownDescription: "Longitude of the building in decimal degrees",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CalendarBuildingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.adminService = pd.adminService
+}
+
+func (d *CalendarBuildingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CalendarBuildingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer := d.customerId
+	if !data.Customer.IsNull() && !data.Customer.IsUnknown() {
+		customer = data.Customer.ValueString()
+	}
+
+	data.Buildings = []CalendarBuildingModel{}
+
+	err := d.adminService.Resources.Buildings.List(customer).Pages(ctx, func(page *admin.Buildings) error {
+		for _, b := range page.Buildings {
+			building := CalendarBuildingModel{
+				BuildingId:   types.StringValue(b.BuildingId),
+				BuildingName: types.StringValue(b.BuildingName),
+				Description:  types.StringValue(b.Description),
+				FloorNames:   []types.String{},
+				Latitude:     types.Float64Null(),
+				Longitude:    types.Float64Null(),
+			}
+			for _, f := range b.FloorNames {
+				building.FloorNames = append(building.FloorNames, types.StringValue(f))
+			}
+			if b.Coordinates != nil {
+				building.Latitude = types.Float64Value(b.Coordinates.Latitude)
+				building.Longitude = types.Float64Value(b.Coordinates.Longitude)
+			}
+			data.Buildings = append(data.Buildings, building)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list buildings for customer '%s', got error: %s", customer, formatAPIError(err)),
+		)
+		return
+	}
+
+	data.Customer = types.StringValue(customer)
+	sortByKey(data.Buildings, func(b CalendarBuildingModel) string { return b.BuildingId.ValueString() })
+
+	data.Id = types.StringValue(customer)
+
+	tflog.Trace(ctx, "read calendar buildings", map[string]interface{}{
+		"customer": customer,
+		"count":    len(data.Buildings),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CalendarResourcesDataSource{}
+
+func NewCalendarResourcesDataSource() datasource.DataSource {
+	return &CalendarResourcesDataSource{}
+}
+
+// CalendarResourcesDataSource defines the data source implementation.
+type CalendarResourcesDataSource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// CalendarResourcesDataSourceModel describes the data source data model.
+type CalendarResourcesDataSourceModel struct {
+	Customer  types.String            `tfsdk:"customer"`
+	OrderBy   types.String            `tfsdk:"order_by"`
+	Query     types.String            `tfsdk:"query"`
+	Resources []CalendarResourceModel `tfsdk:"resources"`
+	Id        types.String            `tfsdk:"id"`
+}
+
+// Nested Model for "resources".
+type CalendarResourceModel struct {
+	ResourceId             types.String `tfsdk:"resource_id"`
+	ResourceName           types.String `tfsdk:"resource_name"`
+	ResourceEmail          types.String `tfsdk:"resource_email"`
+	ResourceType           types.String `tfsdk:"resource_type"`
+	ResourceCategory       types.String `tfsdk:"resource_category"`
+	ResourceDescription    types.String `tfsdk:"resource_description"`
+	UserVisibleDescription types.String `tfsdk:"user_visible_description"`
+	GeneratedResourceName  types.String `tfsdk:"generated_resource_name"`
+	BuildingId             types.String `tfsdk:"building_id"`
+	FloorName              types.String `tfsdk:"floor_name"`
+	FloorSection           types.String `tfsdk:"floor_section"`
+	Capacity               types.Int64  `tfsdk:"capacity"`
+}
+
+func (d *CalendarResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_calendar_resources"
+}
+
+func (d *CalendarResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists all Calendar resources (e.g. meeting rooms) of a customer" + scopesDescription(admin.AdminDirectoryResourceCalendarScope),
+
+		Attributes: map[string]schema.Attribute{
+			"customer": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the customer's Google Workspace account. Defaults to the provider `customer_id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"order_by": schema.StringAttribute{
+				MarkdownDescription: `Field(s) to sort results by in either ascending or descending order.
+				Supported fields include 'resourceId', 'resourceName', 'capacity', 'buildingId',
+				and 'floorName', e.g. 'buildingId, capacity desc'.`,
+				Optional: true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: `String query used to filter results, e.g. 'resourceCategory=CONFERENCE_ROOM'.
+				Supported fields include 'generatedResourceName', 'name', 'buildingId', 'floor_name',
+				'capacity', 'featureInstances.feature.name', 'resourceEmail' and 'resourceCategory'.`,
+				Optional: true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "The Calendar resources of the customer, sorted by resource_id unless order_by is set",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_id": schema.StringAttribute{
+							MarkdownDescription: "The unique ID for the calendar resource",
+							Computed:            true,
+						},
+						"resource_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the calendar resource",
+							Computed:            true,
+						},
+						"resource_email": schema.StringAttribute{
+							MarkdownDescription: "The read-only email for the calendar resource",
+							Computed:            true,
+						},
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the calendar resource, intended for non-room resources",
+							Computed:            true,
+						},
+						"resource_category": schema.StringAttribute{
+							MarkdownDescription: "The category of the calendar resource. Either CONFERENCE_ROOM or OTHER.",
+							Computed:            true,
+						},
+						"resource_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the resource, visible only to admins",
+							Computed:            true,
+						},
+						"user_visible_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the resource, visible to users and admins",
+							Computed:            true,
+						},
+						"generated_resource_name": schema.StringAttribute{
+							MarkdownDescription: "The read-only auto-generated name of the calendar resource",
+							Computed:            true,
+						},
+						"building_id": schema.StringAttribute{
+							MarkdownDescription: "Unique ID for the building the resource is located in",
+							Computed:            true,
+						},
+						"floor_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the floor a resource is located on",
+							Computed:            true,
+						},
+						"floor_section": schema.StringAttribute{
+							MarkdownDescription: "Name of the section within a floor a resource is located in",
+							Computed:            true,
+						},
+						"capacity": schema.Int64Attribute{
+							MarkdownDescription: "Capacity of a resource, number of seats in a room",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CalendarResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.adminService = pd.adminService
+}
+
+func (d *CalendarResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CalendarResourcesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer := d.customerId
+	if !data.Customer.IsNull() && !data.Customer.IsUnknown() {
+		customer = data.Customer.ValueString()
+	}
+
+	call := d.adminService.Resources.Calendars.List(customer)
+	if !data.OrderBy.IsNull() {
+		call = call.OrderBy(data.OrderBy.ValueString())
+	}
+	if !data.Query.IsNull() {
+		call = call.Query(data.Query.ValueString())
+	}
+
+	data.Resources = []CalendarResourceModel{}
+
+	err := call.Pages(ctx, func(page *admin.CalendarResources) error {
+		for _, r := range page.Items {
+			data.Resources = append(data.Resources, CalendarResourceModel{
+				ResourceId:             types.StringValue(r.ResourceId),
+				ResourceName:           types.StringValue(r.ResourceName),
+				ResourceEmail:          types.StringValue(r.ResourceEmail),
+				ResourceType:           types.StringValue(r.ResourceType),
+				ResourceCategory:       types.StringValue(r.ResourceCategory),
+				ResourceDescription:    types.StringValue(r.ResourceDescription),
+				UserVisibleDescription: types.StringValue(r.UserVisibleDescription),
+				GeneratedResourceName:  types.StringValue(r.GeneratedResourceName),
+				BuildingId:             types.StringValue(r.BuildingId),
+				FloorName:              types.StringValue(r.FloorName),
+				FloorSection:           types.StringValue(r.FloorSection),
+				Capacity:               types.Int64Value(r.Capacity),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list calendar resources for customer '%s', got error: %s", customer, formatAPIError(err)),
+		)
+		return
+	}
+
+	data.Customer = types.StringValue(customer)
+	// An explicit order_by is kept as returned.
+	if data.OrderBy.IsNull() {
+		sortByKey(data.Resources, func(r CalendarResourceModel) string { return r.ResourceId.ValueString() })
+	}
+
+	data.Id = types.StringValue(customer)
+
+	tflog.Trace(ctx, "read calendar resources", map[string]interface{}{
+		"customer": customer,
+		"count":    len(data.Resources),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
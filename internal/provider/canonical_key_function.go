@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CanonicalKeyFunction{}
+
+func NewCanonicalKeyFunction() function.Function {
+	return &CanonicalKeyFunction{}
+}
+
+// CanonicalKeyFunction defines the function implementation.
+type CanonicalKeyFunction struct{}
+
+// canonicalKey normalizes a group or user key (usually an email address) so
+// that the same object is always referenced by the same string.
+func canonicalKey(key string) string {
+	return strings.ToLower(strings.TrimSpace(key))
+}
+
+func (f *CanonicalKeyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "canonical_key"
+}
+
+func (f *CanonicalKeyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Canonicalize a group or user key",
+		MarkdownDescription: `Lowercases and trims surrounding whitespace from a group or user key
+		(e.g. an email address), so keys coming from external data can be compared
+		and referenced consistently across resources.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "Group or user key to canonicalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CanonicalKeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, canonicalKey(value)))
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCanonicalKeyFunction(t *testing.T) {
+	tests := map[string]struct {
+		key  string
+		want string
+	}{
+		"empty": {
+			key:  "",
+			want: "",
+		},
+		"already canonical": {
+			key:  "jdoe@example.com",
+			want: "jdoe@example.com",
+		},
+		"case folding": {
+			key:  "JDoe@Example.COM",
+			want: "jdoe@example.com",
+		},
+		"surrounding whitespace": {
+			key:  " \tjdoe@example.com\n",
+			want: "jdoe@example.com",
+		},
+		"inner whitespace is kept": {
+			key:  " Sales Team ",
+			want: "sales team",
+		},
+		"whitespace only": {
+			key:  "   ",
+			want: "",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runTestFunction(t, NewCanonicalKeyFunction(), types.StringValue(test.key))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if want := types.StringValue(test.want); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CelPolicyQueryFunction{}
+
+func NewCelPolicyQueryFunction() function.Function {
+	return &CelPolicyQueryFunction{}
+}
+
+// CelPolicyQueryFunction defines the function implementation.
+type CelPolicyQueryFunction struct{}
+
+// celPolicyLicense is a product and SKU a policy query can be restricted to.
+type celPolicyLicense struct {
+	ProductId string `tfsdk:"product_id"`
+	SkuId     string `tfsdk:"sku_id"`
+}
+
+// celPolicyLicenseAttrTypes are the attribute types of a license object.
+var celPolicyLicenseAttrTypes = map[string]attr.Type{
+	"product_id": types.StringType,
+	"sku_id":     types.StringType,
+}
+
+// celPolicyQuery composes the CEL clauses Cloud Identity uses to scope a
+// policy to an org unit, a group and a set of licenses, joined with &&. Empty
+// selectors are left out, at least one selector is required.
+func celPolicyQuery(orgUnitId, groupId string, licenses []celPolicyLicense) (string, error) {
+	var clauses []string
+
+	for name, value := range map[string]string{"org_unit_id": orgUnitId, "group_id": groupId} {
+		if strings.ContainsAny(value, `'\`) {
+			return "", fmt.Errorf("%s must not contain quotes or backslashes, got %q", name, value)
+		}
+	}
+
+	if orgUnitId != "" {
+		clauses = append(clauses, fmt.Sprintf("entity.org_units.exists(org_unit, org_unit.org_unit_id == orgUnitId('%s'))", orgUnitId))
+	}
+
+	if groupId != "" {
+		clauses = append(clauses, fmt.Sprintf("entity.groups.exists(group, group.group_id == groupId('%s'))", groupId))
+	}
+
+	if len(licenses) > 0 {
+		skus := make([]string, 0, len(licenses))
+		for _, l := range licenses {
+			if l.ProductId == "" || l.SkuId == "" {
+				return "", fmt.Errorf("licenses must have both a product_id and a sku_id")
+			}
+			if strings.ContainsAny(l.ProductId+l.SkuId, `'\/`) {
+				return "", fmt.Errorf("license product_id and sku_id must not contain quotes or slashes, got %q and %q", l.ProductId, l.SkuId)
+			}
+			skus = append(skus, fmt.Sprintf("'/product/%s/sku/%s'", l.ProductId, l.SkuId))
+		}
+		clauses = append(clauses, fmt.Sprintf("entity.licenses.exists(license, license in [%s])", strings.Join(skus, ", ")))
+	}
+
+	if len(clauses) == 0 {
+		return "", fmt.Errorf("at least one of org_unit_id, group_id or licenses must be set")
+	}
+
+	return strings.Join(clauses, " && "), nil
+}
+
+func (f *CelPolicyQueryFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cel_policy_query"
+}
+
+func (f *CelPolicyQueryFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a Cloud Identity policy query",
+		MarkdownDescription: `Composes the CEL query of a Cloud Identity policy from an org unit id,
+		a group id and a list of licenses, e.g. 'cel_policy_query("03ph8a2z1", null, null)'
+		returns "entity.org_units.exists(org_unit, org_unit.org_unit_id == orgUnitId('03ph8a2z1'))".
+		Null or empty selectors are left out and the remaining clauses are joined with &&.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "org_unit_id",
+				MarkdownDescription: "ID of the org unit the policy applies to, without the 'id:' prefix",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "group_id",
+				MarkdownDescription: "ID of the group the policy applies to",
+				AllowNullValue:      true,
+			},
+			function.ListParameter{
+				Name:                "licenses",
+				MarkdownDescription: "Licenses the policy applies to, as objects with a product_id and a sku_id",
+				ElementType:         types.ObjectType{AttrTypes: celPolicyLicenseAttrTypes},
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CelPolicyQueryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var orgUnitId, groupId types.String
+	var licenses []celPolicyLicense
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &orgUnitId, &groupId, &licenses))
+	if resp.Error != nil {
+		return
+	}
+
+	query, err := celPolicyQuery(orgUnitId.ValueString(), groupId.ValueString(), licenses)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, query))
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCelPolicyQueryFunction(t *testing.T) {
+	licenseType := types.ObjectType{AttrTypes: celPolicyLicenseAttrTypes}
+	license := func(productId, skuId string) attr.Value {
+		return types.ObjectValueMust(celPolicyLicenseAttrTypes, map[string]attr.Value{
+			"product_id": types.StringValue(productId),
+			"sku_id":     types.StringValue(skuId),
+		})
+	}
+
+	tests := map[string]struct {
+		orgUnitId types.String
+		groupId   types.String
+		licenses  types.List
+		want      string
+		wantErr   *function.FuncError
+	}{
+		"org unit": {
+			orgUnitId: types.StringValue("03ph8a2z1"),
+			groupId:   types.StringNull(),
+			licenses:  types.ListNull(licenseType),
+			want:      "entity.org_units.exists(org_unit, org_unit.org_unit_id == orgUnitId('03ph8a2z1'))",
+		},
+		"group": {
+			orgUnitId: types.StringNull(),
+			groupId:   types.StringValue("01abc"),
+			licenses:  types.ListNull(licenseType),
+			want:      "entity.groups.exists(group, group.group_id == groupId('01abc'))",
+		},
+		"licenses": {
+			orgUnitId: types.StringNull(),
+			groupId:   types.StringNull(),
+			licenses:  types.ListValueMust(licenseType, []attr.Value{license("Google-Apps", "1010020027"), license("Google-Apps", "1010020028")}),
+			want:      "entity.licenses.exists(license, license in ['/product/Google-Apps/sku/1010020027', '/product/Google-Apps/sku/1010020028'])",
+		},
+		"all selectors": {
+			orgUnitId: types.StringValue("03ph8a2z1"),
+			groupId:   types.StringValue("01abc"),
+			licenses:  types.ListValueMust(licenseType, []attr.Value{license("Google-Apps", "1010020027")}),
+			want: "entity.org_units.exists(org_unit, org_unit.org_unit_id == orgUnitId('03ph8a2z1'))" +
+				" && entity.groups.exists(group, group.group_id == groupId('01abc'))" +
+				" && entity.licenses.exists(license, license in ['/product/Google-Apps/sku/1010020027'])",
+		},
+		"empty selectors are left out": {
+			orgUnitId: types.StringValue(""),
+			groupId:   types.StringValue("01abc"),
+			licenses:  types.ListValueMust(licenseType, []attr.Value{}),
+			want:      "entity.groups.exists(group, group.group_id == groupId('01abc'))",
+		},
+		"no selectors": {
+			orgUnitId: types.StringNull(),
+			groupId:   types.StringNull(),
+			licenses:  types.ListNull(licenseType),
+			wantErr:   function.NewFuncError("at least one of org_unit_id, group_id or licenses must be set"),
+		},
+		"empty selectors": {
+			orgUnitId: types.StringValue(""),
+			groupId:   types.StringValue(""),
+			licenses:  types.ListValueMust(licenseType, []attr.Value{}),
+			wantErr:   function.NewFuncError("at least one of org_unit_id, group_id or licenses must be set"),
+		},
+		"quote in org unit id": {
+			orgUnitId: types.StringValue("03ph'8a2z1"),
+			groupId:   types.StringNull(),
+			licenses:  types.ListNull(licenseType),
+			wantErr:   function.NewFuncError(`org_unit_id must not contain quotes or backslashes, got "03ph'8a2z1"`),
+		},
+		"backslash in group id": {
+			orgUnitId: types.StringNull(),
+			groupId:   types.StringValue(`01\abc`),
+			licenses:  types.ListNull(licenseType),
+			wantErr:   function.NewFuncError(`group_id must not contain quotes or backslashes, got "01\\abc"`),
+		},
+		"license without sku": {
+			orgUnitId: types.StringNull(),
+			groupId:   types.StringNull(),
+			licenses:  types.ListValueMust(licenseType, []attr.Value{license("Google-Apps", "")}),
+			wantErr:   function.NewFuncError("licenses must have both a product_id and a sku_id"),
+		},
+		"slash in license": {
+			orgUnitId: types.StringNull(),
+			groupId:   types.StringNull(),
+			licenses:  types.ListValueMust(licenseType, []attr.Value{license("Google-Apps", "sku/1")}),
+			wantErr:   function.NewFuncError(`license product_id and sku_id must not contain quotes or slashes, got "Google-Apps" and "sku/1"`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runTestFunction(t, NewCelPolicyQueryFunction(), test.orgUnitId, test.groupId, test.licenses)
+			if !err.Equal(test.wantErr) {
+				t.Fatalf("got error %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if want := types.StringValue(test.want); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
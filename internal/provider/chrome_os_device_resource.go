@@ -0,0 +1,319 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChromeOsDeviceResource{}
+var _ resource.ResourceWithImportState = &ChromeOsDeviceResource{}
+var _ resource.ResourceWithValidateConfig = &ChromeOsDeviceResource{}
+
+func NewChromeOsDeviceResource() resource.Resource {
+	return &ChromeOsDeviceResource{}
+}
+
+// ChromeOsDeviceResource defines the resource implementation. Devices are
+// enrolled rather than created, so the resource adopts an existing device.
+type ChromeOsDeviceResource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// ChromeOsDeviceResourceModel describes the resource data model.
+type ChromeOsDeviceResourceModel struct {
+	DeviceId          types.String `tfsdk:"device_id"`
+	OrgUnitPath       types.String `tfsdk:"org_unit_path"`
+	Status            types.String `tfsdk:"status"`
+	DeprovisionReason types.String `tfsdk:"deprovision_reason"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	Model             types.String `tfsdk:"model"`
+	Id                types.String `tfsdk:"id"`
+}
+
+func (r *ChromeOsDeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chrome_os_device"
+}
+
+func (r *ChromeOsDeviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `ChromeOS device resource. Creating the resource adopts an enrolled
+		device, destroying it only removes the device from state.` + scopesDescription(admin.AdminDirectoryDeviceChromeosScope),
+
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the ChromeOS device",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_unit_path": schema.StringAttribute{
+				MarkdownDescription: "The full path of the org unit the device belongs to",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: `The provisioning status of the device, ACTIVE, DISABLED or
+				DEPROVISIONED. Deprovisioning requires deprovision_reason and cannot be undone.`,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringOneOf("ACTIVE", "DISABLED", "DEPROVISIONED"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deprovision_reason": schema.StringAttribute{
+				MarkdownDescription: "The reason the device is deprovisioned, required when status is DEPROVISIONED",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf("same_model_replacement", "different_model_replacement", "retiring_device", "upgrade_transfer"),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "The serial number of the device",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The model of the device",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Device identifier, same as device_id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChromeOsDeviceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ChromeOsDeviceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Status.ValueString() == "DEPROVISIONED" && data.DeprovisionReason.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deprovision_reason"),
+			"Missing Deprovision Reason",
+			"deprovision_reason must be set when status is DEPROVISIONED.",
+		)
+	}
+}
+
+func (r *ChromeOsDeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.customerId = pd.customerId
+	r.adminService = pd.adminService
+}
+
+// Create adopts the device and applies the configured org unit and status.
+func (r *ChromeOsDeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ChromeOsDeviceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Adopted ChromeOS device", map[string]interface{}{
+		"device_id": data.DeviceId.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChromeOsDeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ChromeOsDeviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, err := r.adminService.Chromeosdevices.Get(r.customerId, data.DeviceId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "ChromeOS device no longer exists in Google Workspace, removing from state", map[string]interface{}{
+				"device_id": data.DeviceId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read ChromeOS device '%s', got error: %s", data.DeviceId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenChromeOsDevice(device, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChromeOsDeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ChromeOsDeviceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the device from state, the device itself is never
+// touched.
+func (r *ChromeOsDeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ChromeOsDeviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "ChromeOS devices are not deleted, removing from state only", map[string]interface{}{
+		"device_id": data.DeviceId.ValueString(),
+	})
+}
+
+func (r *ChromeOsDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("device_id"), req, resp)
+}
+
+// apply moves the device to the planned org unit and performs the action
+// needed to reach the planned status, then stores the device in data.
+func (r *ChromeOsDeviceResource) apply(ctx context.Context, data *ChromeOsDeviceResourceModel, diags *diag.Diagnostics) {
+	deviceId := data.DeviceId.ValueString()
+
+	device, err := r.adminService.Chromeosdevices.Get(r.customerId, deviceId).Context(ctx).Do()
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read ChromeOS device '%s', got error: %s", deviceId, formatAPIError(err)),
+		)
+		return
+	}
+
+	if orgUnitPath := data.OrgUnitPath.ValueString(); !data.OrgUnitPath.IsUnknown() && orgUnitPath != "" && orgUnitPath != device.OrgUnitPath {
+		err := r.adminService.Chromeosdevices.MoveDevicesToOu(r.customerId, orgUnitPath, &admin.ChromeOsMoveDevicesToOu{
+			DeviceIds: []string{deviceId},
+		}).Context(ctx).Do()
+		if err != nil {
+			diags.AddError(
+				"Error Moving ChromeOS Device",
+				fmt.Sprintf("Could not move device %s to %s: %v", deviceId, orgUnitPath, formatAPIError(err)),
+			)
+			return
+		}
+		device.OrgUnitPath = orgUnitPath
+	}
+
+	if status := data.Status.ValueString(); !data.Status.IsUnknown() && status != "" && status != device.Status {
+		action := &admin.ChromeOsDeviceAction{}
+		switch status {
+		case "ACTIVE":
+			action.Action = "reenable"
+		case "DISABLED":
+			action.Action = "disable"
+		case "DEPROVISIONED":
+			action.Action = "deprovision"
+			action.DeprovisionReason = data.DeprovisionReason.ValueString()
+		}
+
+		err := r.adminService.Chromeosdevices.Action(r.customerId, deviceId, action).Context(ctx).Do()
+		if err != nil {
+			diags.AddError(
+				"Error Changing ChromeOS Device Status",
+				fmt.Sprintf("Could not %s device %s: %v", action.Action, deviceId, formatAPIError(err)),
+			)
+			return
+		}
+		device.Status = status
+	}
+
+	flattenChromeOsDevice(device, data)
+}
+
+func flattenChromeOsDevice(device *admin.ChromeOsDevice, data *ChromeOsDeviceResourceModel) {
+	data.Id = types.StringValue(device.DeviceId)
+	data.DeviceId = types.StringValue(device.DeviceId)
+	data.OrgUnitPath = types.StringValue(device.OrgUnitPath)
+	data.Status = types.StringValue(device.Status)
+	data.SerialNumber = types.StringValue(device.SerialNumber)
+	data.Model = types.StringValue(device.Model)
+}
@@ -0,0 +1,429 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	chromepolicy "google.golang.org/api/chromepolicy/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChromePolicyResource{}
+var _ resource.ResourceWithImportState = &ChromePolicyResource{}
+var _ resource.ResourceWithModifyPlan = &ChromePolicyResource{}
+
+func NewChromePolicyResource() resource.Resource {
+	return &ChromePolicyResource{}
+}
+
+// ChromePolicyResource defines the resource implementation.
+type ChromePolicyResource struct {
+	client     *http.Client
+	customerId string
+
+	chromepolicyService *chromepolicy.Service
+}
+
+// ChromePolicyResourceModel describes the resource data model.
+type ChromePolicyResourceModel struct {
+	OrgUnitId            types.String `tfsdk:"org_unit_id"`
+	PolicySchema         types.String `tfsdk:"policy_schema"`
+	AdditionalTargetKeys types.Map    `tfsdk:"additional_target_keys"`
+	Value                types.String `tfsdk:"value"`
+	UpdateMask           types.String `tfsdk:"update_mask"`
+	SourceOrgUnit        types.String `tfsdk:"source_org_unit"`
+	Id                   types.String `tfsdk:"id"`
+}
+
+func (r *ChromePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chrome_policy"
+}
+
+func (r *ChromePolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Chrome policy set on an organizational unit, e.g. for ChromeOS devices or
+		managed browsers. Destroying the resource makes the organizational unit inherit the
+		policy from its parent again. A policy that is inherited again outside of Terraform
+		shows up as a change of source_org_unit and is set on the organizational unit on the
+		next apply.` + scopesDescription(chromepolicy.ChromeManagementPolicyScope),
+
+		Attributes: map[string]schema.Attribute{
+			"org_unit_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the organizational unit, with or without the 'id:' prefix",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_schema": schema.StringAttribute{
+				MarkdownDescription: "The fully qualified name of the policy schema, e.g. 'chrome.users.MaxConnectionsPerProxy'",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"additional_target_keys": schema.MapAttribute{
+				MarkdownDescription: "Additional keys identifying the target, e.g. 'app_id' for policies of an app",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: `JSON object with the values of the policy's fields, e.g.
+				'{"maxConnectionsPerProxy": 32}'`,
+				Required: true,
+				Validators: []validator.String{
+					stringIsJSON(),
+				},
+			},
+			"update_mask": schema.StringAttribute{
+				MarkdownDescription: `Comma separated fields of the policy to modify. Defaults to the fields
+				set in value.`,
+				Optional: true,
+			},
+			"source_org_unit": schema.StringAttribute{
+				MarkdownDescription: `ID of the organizational unit the effective value of the policy is set on,
+				without the 'id:' prefix. Differs from org_unit_id while the policy is inherited, and
+				is null when the customer's default applies.`,
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, in the format org_unit_id/policy_schema",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChromePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.customerId = pd.customerId
+	r.chromepolicyService = pd.chromepolicyService
+}
+
+// ModifyPlan plans the policy to be set on the org unit itself, so a policy
+// that is inherited again shows up as a change of source_org_unit even when
+// the inherited value matches the configured one.
+func (r *ChromePolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var orgUnitId types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("org_unit_id"), &orgUnitId)...)
+
+	if resp.Diagnostics.HasError() || orgUnitId.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_org_unit"), chromePolicyOrgUnit(orgUnitId.ValueString()))...)
+}
+
+func (r *ChromePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ChromePolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.modify(ctx, &data, "Error Creating Chrome Policy", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SourceOrgUnit = types.StringValue(chromePolicyOrgUnit(data.OrgUnitId.ValueString()))
+	data.Id = types.StringValue(chromePolicyId(&data))
+
+	tflog.Trace(ctx, "Created Chrome Policy", map[string]interface{}{
+		"org_unit_id":   data.OrgUnitId.ValueString(),
+		"policy_schema": data.PolicySchema.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChromePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ChromePolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targetKey := chromePolicyTargetKey(&data)
+	policySchema := data.PolicySchema.ValueString()
+
+	var resolved *chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy
+	err := r.chromepolicyService.Customers.Policies.Resolve(r.customer(), &chromepolicy.GoogleChromePolicyVersionsV1ResolveRequest{
+		PolicySchemaFilter: policySchema,
+		PolicyTargetKey:    targetKey,
+	}).Pages(ctx, func(page *chromepolicy.GoogleChromePolicyVersionsV1ResolveResponse) error {
+		for _, p := range page.ResolvedPolicies {
+			if p.Value != nil && p.Value.PolicySchema == policySchema && chromePolicyTargetKeyMatches(p.TargetKey, targetKey) {
+				resolved = p
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to resolve Chrome policy '%s' of org unit '%s', got error: %s", policySchema, data.OrgUnitId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	if resolved == nil {
+		tflog.Warn(ctx, "Chrome Policy no longer applies to the org unit, removing from state", map[string]interface{}{
+			"org_unit_id":   data.OrgUnitId.ValueString(),
+			"policy_schema": policySchema,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenChromePolicy(resolved, &data)
+	data.Id = types.StringValue(chromePolicyId(&data))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChromePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ChromePolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.modify(ctx, &data, "Error Updating Chrome Policy", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SourceOrgUnit = types.StringValue(chromePolicyOrgUnit(data.OrgUnitId.ValueString()))
+
+	tflog.Trace(ctx, "Updated Chrome Policy", map[string]interface{}{
+		"org_unit_id":   data.OrgUnitId.ValueString(),
+		"policy_schema": data.PolicySchema.ValueString(),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChromePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ChromePolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.chromepolicyService.Customers.Policies.Orgunits.BatchInherit(r.customer(), &chromepolicy.GoogleChromePolicyVersionsV1BatchInheritOrgUnitPoliciesRequest{
+		Requests: []*chromepolicy.GoogleChromePolicyVersionsV1InheritOrgUnitPolicyRequest{{
+			PolicySchema:    data.PolicySchema.ValueString(),
+			PolicyTargetKey: chromePolicyTargetKey(&data),
+		}},
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Chrome Policy",
+			fmt.Sprintf("Could not make org unit %s inherit Chrome policy %s: %v", data.OrgUnitId.ValueString(), data.PolicySchema.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *ChromePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgUnitId, policySchema, ok := strings.Cut(req.ID, "/")
+	if !ok || orgUnitId == "" || policySchema == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier in the format org_unit_id/policy_schema, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_unit_id"), orgUnitId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_schema"), policySchema)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// customer returns the customer in the resource name format the Chrome
+// Policy API expects.
+func (r *ChromePolicyResource) customer() string {
+	return "customers/" + r.customerId
+}
+
+// modify sets the policy value in data on its org unit.
+func (r *ChromePolicyResource) modify(ctx context.Context, data *ChromePolicyResourceModel, summary string, diags *diag.Diagnostics) {
+	value := data.Value.ValueString()
+
+	mask := data.UpdateMask.ValueString()
+	if data.UpdateMask.IsNull() || data.UpdateMask.IsUnknown() {
+		mask = chromePolicyUpdateMask(value)
+	}
+
+	_, err := r.chromepolicyService.Customers.Policies.Orgunits.BatchModify(r.customer(), &chromepolicy.GoogleChromePolicyVersionsV1BatchModifyOrgUnitPoliciesRequest{
+		Requests: []*chromepolicy.GoogleChromePolicyVersionsV1ModifyOrgUnitPolicyRequest{{
+			PolicyTargetKey: chromePolicyTargetKey(data),
+			PolicyValue: &chromepolicy.GoogleChromePolicyVersionsV1PolicyValue{
+				PolicySchema: data.PolicySchema.ValueString(),
+				Value:        googleapi.RawMessage(value),
+			},
+			UpdateMask: mask,
+		}},
+	}).Context(ctx).Do()
+	if err != nil {
+		diags.AddError(
+			summary,
+			fmt.Sprintf("Could not set Chrome policy %s on org unit %s: %v", data.PolicySchema.ValueString(), data.OrgUnitId.ValueString(), formatAPIError(err)),
+		)
+	}
+}
+
+// flattenChromePolicy stores the effective value of a policy and the org unit
+// it is set on in data. A policy inherited from a parent org unit, or the
+// customer's default, is recorded with that source so it shows up as drift.
+func flattenChromePolicy(resolved *chromepolicy.GoogleChromePolicyVersionsV1ResolvedPolicy, data *ChromePolicyResourceModel) {
+	data.SourceOrgUnit = types.StringNull()
+	if resolved.SourceKey != nil {
+		data.SourceOrgUnit = stringOrNull(strings.TrimPrefix(resolved.SourceKey.TargetResource, "orgunits/"))
+	}
+
+	// Google returns every field of the policy, keep the configured value
+	// as long as the fields it sets still hold.
+	value := string(resolved.Value.Value)
+	if !data.Value.IsNull() && chromePolicyValueMatches(data.Value.ValueString(), value) {
+		value = data.Value.ValueString()
+	}
+	data.Value = types.StringValue(value)
+}
+
+// chromePolicyOrgUnit returns an org unit ID without the 'id:' prefix.
+func chromePolicyOrgUnit(orgUnitId string) string {
+	return strings.TrimPrefix(orgUnitId, "id:")
+}
+
+// chromePolicyTargetKeyMatches reports whether a resolved policy applies to
+// the target key, including its additional target keys such as an app ID.
+func chromePolicyTargetKeyMatches(resolved, key *chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey) bool {
+	if resolved == nil {
+		return true
+	}
+
+	return resolved.TargetResource == key.TargetResource && maps.Equal(resolved.AdditionalTargetKeys, key.AdditionalTargetKeys)
+}
+
+func chromePolicyId(data *ChromePolicyResourceModel) string {
+	return data.OrgUnitId.ValueString() + "/" + data.PolicySchema.ValueString()
+}
+
+func chromePolicyTargetKey(data *ChromePolicyResourceModel) *chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey {
+	key := &chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey{
+		TargetResource: "orgunits/" + chromePolicyOrgUnit(data.OrgUnitId.ValueString()),
+	}
+
+	if !data.AdditionalTargetKeys.IsNull() && !data.AdditionalTargetKeys.IsUnknown() {
+		key.AdditionalTargetKeys = map[string]string{}
+		for k, v := range data.AdditionalTargetKeys.Elements() {
+			if s, ok := v.(types.String); ok {
+				key.AdditionalTargetKeys[k] = s.ValueString()
+			}
+		}
+	}
+
+	return key
+}
+
+// chromePolicyUpdateMask returns the top level fields of value, sorted so
+// that the mask is stable.
+func chromePolicyUpdateMask(value string) string {
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return ""
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return strings.Join(keys, ",")
+}
+
+// chromePolicyValueMatches reports whether every field of the configured
+// value holds the same value in the resolved one.
+func chromePolicyValueMatches(configured, resolved string) bool {
+	var c, r map[string]interface{}
+	if err := json.Unmarshal([]byte(configured), &c); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(resolved), &r); err != nil {
+		return false
+	}
+
+	for k, v := range c {
+		if !reflect.DeepEqual(v, r[k]) {
+			return false
+		}
+	}
+
+	return true
+}
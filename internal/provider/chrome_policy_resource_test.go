@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	chromepolicy "google.golang.org/api/chromepolicy/v1"
+)
+
+// newTestChromePolicyResource returns a resource whose Resolve calls return
+// the resolved policies in body.
+func newTestChromePolicyResource(t *testing.T, body string) *ChromePolicyResource {
+	t.Helper()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/customers/C01/policies:resolve" {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			http.NotFound(w, req)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, body)
+	})
+
+	srv, err := chromepolicy.NewService(context.Background(), newTestClientOptions(t, handler)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &ChromePolicyResource{customerId: "C01", chromepolicyService: srv}
+}
+
+// testChromePolicyModel returns the state of a policy set on org unit
+// 03ph8a2z1.
+func testChromePolicyModel() ChromePolicyResourceModel {
+	return ChromePolicyResourceModel{
+		OrgUnitId:            types.StringValue("id:03ph8a2z1"),
+		PolicySchema:         types.StringValue("chrome.users.MaxConnectionsPerProxy"),
+		AdditionalTargetKeys: types.MapNull(types.StringType),
+		Value:                types.StringValue(`{"maxConnectionsPerProxy":32}`),
+		UpdateMask:           types.StringNull(),
+		SourceOrgUnit:        types.StringValue("03ph8a2z1"),
+		Id:                   types.StringValue("id:03ph8a2z1/chrome.users.MaxConnectionsPerProxy"),
+	}
+}
+
+func TestChromePolicyResourceRead(t *testing.T) {
+	tests := map[string]struct {
+		body              string
+		wantRemoved       bool
+		wantValue         string
+		wantSourceOrgUnit types.String
+	}{
+		"unchanged": {
+			body: `{"resolvedPolicies":[{
+				"targetKey":{"targetResource":"orgunits/03ph8a2z1"},
+				"sourceKey":{"targetResource":"orgunits/03ph8a2z1"},
+				"value":{"policySchema":"chrome.users.MaxConnectionsPerProxy","value":{"maxConnectionsPerProxy":32,"other":true}}}]}`,
+			wantValue:         `{"maxConnectionsPerProxy":32}`,
+			wantSourceOrgUnit: types.StringValue("03ph8a2z1"),
+		},
+		"changed outside of Terraform": {
+			body: `{"resolvedPolicies":[{
+				"targetKey":{"targetResource":"orgunits/03ph8a2z1"},
+				"sourceKey":{"targetResource":"orgunits/03ph8a2z1"},
+				"value":{"policySchema":"chrome.users.MaxConnectionsPerProxy","value":{"maxConnectionsPerProxy":16}}}]}`,
+			wantValue:         `{"maxConnectionsPerProxy":16}`,
+			wantSourceOrgUnit: types.StringValue("03ph8a2z1"),
+		},
+		"inherited from the parent": {
+			body: `{"resolvedPolicies":[{
+				"targetKey":{"targetResource":"orgunits/03ph8a2z1"},
+				"sourceKey":{"targetResource":"orgunits/01parent"},
+				"value":{"policySchema":"chrome.users.MaxConnectionsPerProxy","value":{"maxConnectionsPerProxy":32}}}]}`,
+			wantValue:         `{"maxConnectionsPerProxy":32}`,
+			wantSourceOrgUnit: types.StringValue("01parent"),
+		},
+		"customer default": {
+			body: `{"resolvedPolicies":[{
+				"targetKey":{"targetResource":"orgunits/03ph8a2z1"},
+				"value":{"policySchema":"chrome.users.MaxConnectionsPerProxy","value":{"maxConnectionsPerProxy":32}}}]}`,
+			wantValue:         `{"maxConnectionsPerProxy":32}`,
+			wantSourceOrgUnit: types.StringNull(),
+		},
+		"policy of another app": {
+			body: `{"resolvedPolicies":[{
+				"targetKey":{"targetResource":"orgunits/03ph8a2z1","additionalTargetKeys":{"app_id":"chrome:abc"}},
+				"sourceKey":{"targetResource":"orgunits/03ph8a2z1"},
+				"value":{"policySchema":"chrome.users.MaxConnectionsPerProxy","value":{"maxConnectionsPerProxy":32}}}]}`,
+			wantRemoved: true,
+		},
+		"not resolved": {
+			body:        `{}`,
+			wantRemoved: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newTestChromePolicyResource(t, test.body)
+
+			state := newTestResourceState(t, r, testChromePolicyModel())
+			resp := &resource.ReadResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != test.wantRemoved {
+				t.Fatalf("got removed from state: %t, want %t", resp.State.Raw.IsNull(), test.wantRemoved)
+			}
+			if test.wantRemoved {
+				return
+			}
+
+			var data ChromePolicyResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if data.Value.ValueString() != test.wantValue {
+				t.Errorf("got value %s, want %s", data.Value.ValueString(), test.wantValue)
+			}
+			if !data.SourceOrgUnit.Equal(test.wantSourceOrgUnit) {
+				t.Errorf("got source_org_unit %s, want %s", data.SourceOrgUnit, test.wantSourceOrgUnit)
+			}
+		})
+	}
+}
+
+func TestChromePolicyResourceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &ChromePolicyResource{}
+
+	// The state after a refresh of an inherited policy.
+	inherited := testChromePolicyModel()
+	inherited.SourceOrgUnit = types.StringValue("01parent")
+
+	plan := newTestResourceState(t, r, inherited)
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: plan}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var sourceOrgUnit types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("source_org_unit"), &sourceOrgUnit)...)
+	if want := types.StringValue("03ph8a2z1"); !sourceOrgUnit.Equal(want) {
+		t.Errorf("got planned source_org_unit %s, want %s", sourceOrgUnit, want)
+	}
+}
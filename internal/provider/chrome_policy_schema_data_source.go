@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	chromepolicy "google.golang.org/api/chromepolicy/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ChromePolicySchemaDataSource{}
+
+func NewChromePolicySchemaDataSource() datasource.DataSource {
+	return &ChromePolicySchemaDataSource{}
+}
+
+// ChromePolicySchemaDataSource defines the data source implementation.
+type ChromePolicySchemaDataSource struct {
+	client     *http.Client
+	customerId string
+
+	chromepolicyService *chromepolicy.Service
+}
+
+// ChromePolicySchemaDataSourceModel describes the data source data model.
+type ChromePolicySchemaDataSourceModel struct {
+	SchemaName               types.String                                   `tfsdk:"schema_name"`
+	PolicyDescription        types.String                                   `tfsdk:"policy_description"`
+	CategoryTitle            types.String                                   `tfsdk:"category_title"`
+	SupportUri               types.String                                   `tfsdk:"support_uri"`
+	SupportedPlatforms       []types.String                                 `tfsdk:"supported_platforms"`
+	ValidTargetResources     []types.String                                 `tfsdk:"valid_target_resources"`
+	AdditionalTargetKeyNames []types.String                                 `tfsdk:"additional_target_key_names"`
+	FieldDescriptions        []ChromePolicySchemaDataSourceFieldDescription `tfsdk:"field_descriptions"`
+	Id                       types.String                                   `tfsdk:"id"`
+}
+
+// Nested Model for "field_descriptions".
+type ChromePolicySchemaDataSourceFieldDescription struct {
+	Field        types.String                             `tfsdk:"field"`
+	Description  types.String                             `tfsdk:"description"`
+	DefaultValue types.String                             `tfsdk:"default_value"`
+	KnownValues  []ChromePolicySchemaDataSourceKnownValue `tfsdk:"known_values"`
+}
+
+// Nested Model for "field_descriptions.known_values".
+type ChromePolicySchemaDataSourceKnownValue struct {
+	Value       types.String `tfsdk:"value"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *ChromePolicySchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chrome_policy_schema"
+}
+
+func (d *ChromePolicySchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Definition of a Chrome policy schema, describing the fields that make up
+		the value of googleworkspace_chrome_policy` + scopesDescription(chromepolicy.ChromeManagementPolicyScope),
+
+		Attributes: map[string]schema.Attribute{
+			"schema_name": schema.StringAttribute{
+				MarkdownDescription: "The fully qualified name of the schema, e.g. 'chrome.users.MaxConnectionsPerProxy'",
+				Required:            true,
+			},
+			"policy_description": schema.StringAttribute{
+				MarkdownDescription: "Description of the policy",
+				Computed:            true,
+			},
+			"category_title": schema.StringAttribute{
+				MarkdownDescription: "Title of the category the policy belongs to",
+				Computed:            true,
+			},
+			"support_uri": schema.StringAttribute{
+				MarkdownDescription: "URI of the related support article",
+				Computed:            true,
+			},
+			"supported_platforms": schema.ListAttribute{
+				MarkdownDescription: "The platforms the policy applies to, e.g. 'CHROME_OS'",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"valid_target_resources": schema.ListAttribute{
+				MarkdownDescription: "The kinds of targets the policy can be set on, e.g. 'ORG_UNIT'",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"additional_target_key_names": schema.ListAttribute{
+				MarkdownDescription: "The additional_target_keys the policy requires, e.g. 'app_id'",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"field_descriptions": schema.ListNestedAttribute{
+				MarkdownDescription: `The fields of the policy. Nested fields follow their parent, with the
+				path to them joined by dots.`,
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							MarkdownDescription: "Name of the field, e.g. 'maxConnectionsPerProxy'",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the field",
+							Computed:            true,
+						},
+						"default_value": schema.StringAttribute{
+							MarkdownDescription: "JSON encoded default value of the field, null when there is none",
+							Computed:            true,
+						},
+						"known_values": schema.ListNestedAttribute{
+							MarkdownDescription: "The values an enum field accepts",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The value",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "Description of what the value means",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier, the resource name of the schema",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ChromePolicySchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.chromepolicyService = pd.chromepolicyService
+}
+
+func (d *ChromePolicySchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ChromePolicySchemaDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := fmt.Sprintf("customers/%s/policySchemas/%s", d.customerId, data.SchemaName.ValueString())
+
+	s, err := d.chromepolicyService.Customers.PolicySchemas.Get(name).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Chrome policy schema '%s', got error: %s", data.SchemaName.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(s.Name)
+	data.SchemaName = types.StringValue(s.SchemaName)
+	data.PolicyDescription = types.StringValue(s.PolicyDescription)
+	data.CategoryTitle = types.StringValue(s.CategoryTitle)
+	data.SupportUri = types.StringValue(s.SupportUri)
+
+	data.SupportedPlatforms = make([]types.String, 0, len(s.SupportedPlatforms))
+	for _, p := range s.SupportedPlatforms {
+		data.SupportedPlatforms = append(data.SupportedPlatforms, types.StringValue(p))
+	}
+
+	data.ValidTargetResources = make([]types.String, 0, len(s.ValidTargetResources))
+	for _, t := range s.ValidTargetResources {
+		data.ValidTargetResources = append(data.ValidTargetResources, types.StringValue(t))
+	}
+
+	data.AdditionalTargetKeyNames = make([]types.String, 0, len(s.AdditionalTargetKeyNames))
+	for _, k := range s.AdditionalTargetKeyNames {
+		data.AdditionalTargetKeyNames = append(data.AdditionalTargetKeyNames, types.StringValue(k.Key))
+	}
+
+	data.FieldDescriptions = []ChromePolicySchemaDataSourceFieldDescription{}
+	flattenChromePolicyFieldDescriptions("", s.FieldDescriptions, &data.FieldDescriptions)
+
+	tflog.Trace(ctx, "read Chrome policy schema", map[string]interface{}{
+		"schema_name": s.SchemaName,
+		"fields":      len(data.FieldDescriptions),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenChromePolicyFieldDescriptions appends fields and, depth first, their
+// nested fields to out, prefixing field names with the path to them.
+func flattenChromePolicyFieldDescriptions(prefix string, fields []*chromepolicy.GoogleChromePolicyVersionsV1PolicySchemaFieldDescription, out *[]ChromePolicySchemaDataSourceFieldDescription) {
+	for _, f := range fields {
+		field := ChromePolicySchemaDataSourceFieldDescription{
+			Field:        types.StringValue(prefix + f.Field),
+			Description:  types.StringValue(chromePolicyFieldDescription(f)),
+			DefaultValue: types.StringNull(),
+			KnownValues:  make([]ChromePolicySchemaDataSourceKnownValue, 0, len(f.KnownValueDescriptions)),
+		}
+
+		if f.DefaultValue != nil {
+			if b, err := json.Marshal(f.DefaultValue); err == nil {
+				field.DefaultValue = types.StringValue(string(b))
+			}
+		}
+
+		for _, v := range f.KnownValueDescriptions {
+			field.KnownValues = append(field.KnownValues, ChromePolicySchemaDataSourceKnownValue{
+				Value:       types.StringValue(v.Value),
+				Description: types.StringValue(v.Description),
+			})
+		}
+
+		*out = append(*out, field)
+
+		flattenChromePolicyFieldDescriptions(prefix+f.Field+".", f.NestedFieldDescriptions, out)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	chromepolicy "google.golang.org/api/chromepolicy/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ChromePolicySchemasDataSource{}
+
+func NewChromePolicySchemasDataSource() datasource.DataSource {
+	return &ChromePolicySchemasDataSource{}
+}
+
+// ChromePolicySchemasDataSource defines the data source implementation.
+type ChromePolicySchemasDataSource struct {
+	client     *http.Client
+	customerId string
+
+	chromepolicyService *chromepolicy.Service
+}
+
+// ChromePolicySchemasDataSourceModel describes the data source data model.
+type ChromePolicySchemasDataSourceModel struct {
+	Filter  types.String                          `tfsdk:"filter"`
+	Schemas []ChromePolicySchemasDataSourceSchema `tfsdk:"schemas"`
+	Id      types.String                          `tfsdk:"id"`
+}
+
+// Nested Model for "schemas".
+type ChromePolicySchemasDataSourceSchema struct {
+	SchemaName        types.String                                    `tfsdk:"schema_name"`
+	PolicyDescription types.String                                    `tfsdk:"policy_description"`
+	FieldDescriptions []ChromePolicySchemasDataSourceFieldDescription `tfsdk:"field_descriptions"`
+}
+
+// Nested Model for "schemas.field_descriptions".
+type ChromePolicySchemasDataSourceFieldDescription struct {
+	Field       types.String `tfsdk:"field"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *ChromePolicySchemasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chrome_policy_schemas"
+}
+
+func (d *ChromePolicySchemasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Lists the Chrome policy schemas that can be set with
+		googleworkspace_chrome_policy. Use googleworkspace_chrome_policy_schema for the full
+		definition of a single schema.` + scopesDescription(chromepolicy.ChromeManagementPolicyScope),
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: `Filter on the schemas, e.g. 'name=customers/my_customer/policySchemas/chrome.users.*'
+				or 'category_title="Browser"'`,
+				Optional: true,
+			},
+			"schemas": schema.ListNestedAttribute{
+				MarkdownDescription: "The policy schemas, sorted by schema_name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schema_name": schema.StringAttribute{
+							MarkdownDescription: "The fully qualified name of the schema, used as policy_schema",
+							Computed:            true,
+						},
+						"policy_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the policy",
+							Computed:            true,
+						},
+						"field_descriptions": schema.ListNestedAttribute{
+							MarkdownDescription: "The top level fields of the policy",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"field": schema.StringAttribute{
+										MarkdownDescription: "Name of the field, as used in the policy value",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "Description of the field",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ChromePolicySchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.chromepolicyService = pd.chromepolicyService
+}
+
+func (d *ChromePolicySchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ChromePolicySchemasDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parent := "customers/" + d.customerId
+
+	call := d.chromepolicyService.Customers.PolicySchemas.List(parent).PageSize(1000)
+	if !data.Filter.IsNull() {
+		call = call.Filter(data.Filter.ValueString())
+	}
+
+	data.Schemas = []ChromePolicySchemasDataSourceSchema{}
+
+	err := call.Pages(ctx, func(page *chromepolicy.GoogleChromePolicyVersionsV1ListPolicySchemasResponse) error {
+		for _, s := range page.PolicySchemas {
+			data.Schemas = append(data.Schemas, flattenChromePolicySchemaSummary(s))
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list Chrome policy schemas of '%s', got error: %s", parent, formatAPIError(err)),
+		)
+		return
+	}
+
+	sortByKey(data.Schemas, func(s ChromePolicySchemasDataSourceSchema) string { return s.SchemaName.ValueString() })
+
+	data.Id = types.StringValue(parent)
+
+	tflog.Trace(ctx, "read Chrome policy schemas", map[string]interface{}{
+		"filter": data.Filter.ValueString(),
+		"count":  len(data.Schemas),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenChromePolicySchemaSummary(s *chromepolicy.GoogleChromePolicyVersionsV1PolicySchema) ChromePolicySchemasDataSourceSchema {
+	summary := ChromePolicySchemasDataSourceSchema{
+		SchemaName:        types.StringValue(s.SchemaName),
+		PolicyDescription: types.StringValue(s.PolicyDescription),
+		FieldDescriptions: make([]ChromePolicySchemasDataSourceFieldDescription, 0, len(s.FieldDescriptions)),
+	}
+
+	for _, f := range s.FieldDescriptions {
+		summary.FieldDescriptions = append(summary.FieldDescriptions, ChromePolicySchemasDataSourceFieldDescription{
+			Field:       types.StringValue(f.Field),
+			Description: types.StringValue(chromePolicyFieldDescription(f)),
+		})
+	}
+
+	return summary
+}
+
+// chromePolicyFieldDescription returns the description of f, falling back
+// to the deprecated description field that older schemas still use.
+func chromePolicyFieldDescription(f *chromepolicy.GoogleChromePolicyVersionsV1PolicySchemaFieldDescription) string {
+	if f.FieldDescription != "" {
+		return f.FieldDescription
+	}
+	return f.Description
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/cloudidentity/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudIdentityDevicesDataSource{}
+
+func NewCloudIdentityDevicesDataSource() datasource.DataSource {
+	return &CloudIdentityDevicesDataSource{}
+}
+
+// CloudIdentityDevicesDataSource defines the data source implementation.
+type CloudIdentityDevicesDataSource struct {
+	client     *http.Client
+	customerId string
+
+	cloudidentityService *cloudidentity.Service
+}
+
+// CloudIdentityDevicesDataSourceModel describes the data source data model.
+type CloudIdentityDevicesDataSourceModel struct {
+	Customer types.String               `tfsdk:"customer"`
+	Filter   types.String               `tfsdk:"filter"`
+	Devices  []CloudIdentityDeviceModel `tfsdk:"devices"`
+	Id       types.String               `tfsdk:"id"`
+}
+
+// Nested Model for "devices".
+type CloudIdentityDeviceModel struct {
+	Name             types.String `tfsdk:"name"`
+	DeviceType       types.String `tfsdk:"device_type"`
+	OsVersion        types.String `tfsdk:"os_version"`
+	OwnerType        types.String `tfsdk:"owner_type"`
+	CompromisedState types.String `tfsdk:"compromised_state"`
+}
+
+func (d *CloudIdentityDevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_identity_devices"
+}
+
+func (d *CloudIdentityDevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the devices known to Cloud Identity" + scopesDescription(cloudidentity.CloudIdentityDevicesReadonlyScope),
+
+		Attributes: map[string]schema.Attribute{
+			"customer": schema.StringAttribute{
+				MarkdownDescription: `Resource name of the customer in the format 'customers/{customerId}'.
+				Defaults to the provider customer_id.`,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: `Additional restrictions when fetching the list of devices. For
+				the supported syntax see https://support.google.com/a/answer/7549103`,
+				Optional: true,
+			},
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "The devices matching the filter, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Resource name of the device in the format 'devices/{device}'",
+							Computed:            true,
+						},
+						"device_type": schema.StringAttribute{
+							MarkdownDescription: "Type of device, e.g. ANDROID, IOS, WINDOWS or CHROME_OS",
+							Computed:            true,
+						},
+						"os_version": schema.StringAttribute{
+							MarkdownDescription: "OS version of the device",
+							Computed:            true,
+						},
+						"owner_type": schema.StringAttribute{
+							MarkdownDescription: "Whether the device is owned by the company (COMPANY) or an individual (BYOD)",
+							Computed:            true,
+						},
+						"compromised_state": schema.StringAttribute{
+							MarkdownDescription: "Represents whether the device is compromised",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CloudIdentityDevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.cloudidentityService = pd.cloudidentityService
+}
+
+func (d *CloudIdentityDevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudIdentityDevicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer := "customers/" + d.customerId
+	if !data.Customer.IsNull() && !data.Customer.IsUnknown() {
+		customer = data.Customer.ValueString()
+	}
+
+	call := d.cloudidentityService.Devices.List().Customer(customer)
+	if !data.Filter.IsNull() {
+		call = call.Filter(data.Filter.ValueString())
+	}
+
+	data.Devices = []CloudIdentityDeviceModel{}
+
+	err := call.Pages(ctx, func(page *cloudidentity.GoogleAppsCloudidentityDevicesV1ListDevicesResponse) error {
+		for _, device := range page.Devices {
+			data.Devices = append(data.Devices, CloudIdentityDeviceModel{
+				Name:             types.StringValue(device.Name),
+				DeviceType:       types.StringValue(device.DeviceType),
+				OsVersion:        types.StringValue(device.OsVersion),
+				OwnerType:        types.StringValue(device.OwnerType),
+				CompromisedState: types.StringValue(device.CompromisedState),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list Cloud Identity devices for '%s': %s", customer, formatAPIError(err)),
+		)
+		return
+	}
+
+	data.Customer = types.StringValue(customer)
+	sortByKey(data.Devices, func(d CloudIdentityDeviceModel) string { return d.Name.ValueString() })
+
+	data.Id = types.StringValue(customer)
+
+	tflog.Trace(ctx, "read cloud identity devices", map[string]interface{}{
+		"customer": customer,
+		"count":    len(data.Devices),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
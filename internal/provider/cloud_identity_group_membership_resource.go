@@ -0,0 +1,401 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/cloudidentity/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudIdentityGroupMembershipResource{}
+var _ resource.ResourceWithImportState = &CloudIdentityGroupMembershipResource{}
+
+func NewCloudIdentityGroupMembershipResource() resource.Resource {
+	return &CloudIdentityGroupMembershipResource{}
+}
+
+// CloudIdentityGroupMembershipResource defines the resource implementation.
+type CloudIdentityGroupMembershipResource struct {
+	client *http.Client
+
+	cloudidentityService *cloudidentity.Service
+
+	readRetryTimeout time.Duration
+}
+
+// CloudIdentityGroupMembershipResourceModel describes the resource data model.
+type CloudIdentityGroupMembershipResourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	Group     types.String `tfsdk:"group"`
+	MemberKey types.String `tfsdk:"member_key"`
+	Roles     types.Set    `tfsdk:"roles"`
+	Type      types.String `tfsdk:"type"`
+	Id        types.String `tfsdk:"id"`
+}
+
+func (r *CloudIdentityGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_identity_group_membership"
+}
+
+func (r *CloudIdentityGroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cloud Identity group membership resource" + scopesDescription(cloudidentity.CloudIdentityGroupsScope),
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Resource name of the membership. Format: groups/{group}/memberships/{membership}.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Resource name of the group. Format: groups/{group}.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_key": schema.StringAttribute{
+				MarkdownDescription: "Email address of the member",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"roles": schema.SetAttribute{
+				MarkdownDescription: `Roles of the member in the group, any of MEMBER, MANAGER and OWNER.
+				Every member holds the MEMBER role. Defaults to MEMBER.`,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("MEMBER"),
+				})),
+				Validators: []validator.Set{
+					setValuesOneOf("MEMBER", "MANAGER", "OWNER"),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the member, e.g. USER, GROUP or SERVICE_ACCOUNT",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Membership identifier, same as name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CloudIdentityGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.cloudidentityService = pd.cloudidentityService
+	r.readRetryTimeout = pd.readRetryTimeout
+}
+
+func (r *CloudIdentityGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CloudIdentityGroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roles []string
+	resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	membership := &cloudidentity.Membership{
+		PreferredMemberKey: &cloudidentity.EntityKey{Id: data.MemberKey.ValueString()},
+		Roles:              expandMembershipRoles(roles),
+	}
+
+	group := data.Group.ValueString()
+	memberKey := data.MemberKey.ValueString()
+
+	op, err := r.cloudidentityService.Groups.Memberships.Create(group, membership).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Cloud Identity Group Membership",
+			fmt.Sprintf("Could not add %s to group %s: %v", memberKey, group, formatAPIError(err)),
+		)
+		return
+	}
+
+	var created cloudidentity.Membership
+	done, err := operationResponse(op, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Cloud Identity Group Membership",
+			fmt.Sprintf("Could not add %s to group %s: %v", memberKey, group, formatAPIError(err)),
+		)
+		return
+	}
+
+	// Cloud Identity does not expose its operations for polling, so when the
+	// operation is still running wait for the membership to become resolvable.
+	membershipName := created.Name
+	if !done || membershipName == "" {
+		lookup, err := waitForOperation(ctx, r.readRetryTimeout, func(ctx context.Context) (*cloudidentity.LookupMembershipNameResponse, bool, error) {
+			res, err := r.cloudidentityService.Groups.Memberships.Lookup(group).MemberKeyId(memberKey).Context(ctx).Do()
+			if isNotFound(err) {
+				return nil, false, nil
+			}
+			return res, err == nil, err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading created Cloud Identity Group Membership",
+				fmt.Sprintf("%s was added to group %s but the membership could not be looked up: %v", memberKey, group, formatAPIError(err)),
+			)
+			return
+		}
+		membershipName = lookup.Name
+	}
+
+	res, err := readWithRetry(ctx, r.readRetryTimeout, func(ctx context.Context) (*cloudidentity.Membership, error) {
+		return r.cloudidentityService.Groups.Memberships.Get(membershipName).Context(ctx).Do()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading created Cloud Identity Group Membership",
+			fmt.Sprintf("%s was added to group %s but the membership could not be read back: %v", memberKey, group, formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenCloudIdentityGroupMembership(ctx, res, &data)...)
+
+	tflog.Trace(ctx, "Created Cloud Identity Group Membership", map[string]interface{}{
+		"name":       res.Name,
+		"member_key": memberKey,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudIdentityGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CloudIdentityGroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.cloudidentityService.Groups.Memberships.Get(data.Name.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Cloud Identity Group Membership no longer exists, removing from state", map[string]interface{}{
+				"name": data.Name.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Cloud Identity Group Membership '%s', got error: %s", data.Name.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenCloudIdentityGroupMembership(ctx, res, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudIdentityGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CloudIdentityGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned, current []string
+	resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Roles.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the roles can change in place, everything else requires a new
+	// membership.
+	modify := &cloudidentity.ModifyMembershipRolesRequest{}
+	for _, role := range planned {
+		if !slices.Contains(current, role) {
+			modify.AddRoles = append(modify.AddRoles, &cloudidentity.MembershipRole{Name: role})
+		}
+	}
+	for _, role := range current {
+		if !slices.Contains(planned, role) {
+			modify.RemoveRoles = append(modify.RemoveRoles, role)
+		}
+	}
+
+	if len(modify.AddRoles) > 0 || len(modify.RemoveRoles) > 0 {
+		res, err := r.cloudidentityService.Groups.Memberships.ModifyMembershipRoles(data.Name.ValueString(), modify).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Cloud Identity Group Membership",
+				fmt.Sprintf("Could not modify the roles of membership %s: %v", data.Name.ValueString(), formatAPIError(err)),
+			)
+			return
+		}
+
+		if res.Membership != nil {
+			resp.Diagnostics.Append(flattenCloudIdentityGroupMembership(ctx, res.Membership, &data)...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudIdentityGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CloudIdentityGroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op, err := r.cloudidentityService.Groups.Memberships.Delete(data.Name.ValueString()).Context(ctx).Do()
+	if err == nil {
+		_, err = operationResponse(op, nil)
+	}
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "Cloud Identity Group Membership already deleted", map[string]interface{}{
+				"name": data.Name.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Cloud Identity Group Membership",
+			fmt.Sprintf("Could not delete membership %s: %v", data.Name.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *CloudIdentityGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	group, ok := membershipGroup(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a membership resource name in the format groups/{group}/memberships/{membership}, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), group)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func expandMembershipRoles(roles []string) []*cloudidentity.MembershipRole {
+	res := make([]*cloudidentity.MembershipRole, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, &cloudidentity.MembershipRole{Name: role})
+	}
+
+	return res
+}
+
+func flattenCloudIdentityGroupMembership(ctx context.Context, m *cloudidentity.Membership, data *CloudIdentityGroupMembershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringValue(m.Name)
+	data.Id = types.StringValue(m.Name)
+	data.Type = types.StringValue(m.Type)
+
+	if group, ok := membershipGroup(m.Name); ok {
+		data.Group = types.StringValue(group)
+	}
+
+	// Google may normalize the casing of the member key, keep the configured
+	// value when it refers to the same member.
+	if m.PreferredMemberKey != nil && !strings.EqualFold(data.MemberKey.ValueString(), m.PreferredMemberKey.Id) {
+		data.MemberKey = types.StringValue(m.PreferredMemberKey.Id)
+	}
+
+	roles := make([]string, 0, len(m.Roles))
+	for _, role := range m.Roles {
+		roles = append(roles, role.Name)
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, roles)
+	diags.Append(d...)
+	data.Roles = set
+
+	return diags
+}
+
+// membershipGroup returns the group resource name of a membership resource
+// name in the format groups/{group}/memberships/{membership}.
+func membershipGroup(name string) (string, bool) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "groups" || parts[2] != "memberships" || parts[1] == "" || parts[3] == "" {
+		return "", false
+	}
+
+	return parts[0] + "/" + parts[1], true
+}
@@ -0,0 +1,493 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/cloudidentity/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudIdentityGroupResource{}
+var _ resource.ResourceWithImportState = &CloudIdentityGroupResource{}
+
+// discussionForumLabel marks a Cloud Identity group as a Google Group, it is
+// required on every group created through the API.
+const discussionForumLabel = "cloudidentity.googleapis.com/groups.discussion_forum"
+
+func NewCloudIdentityGroupResource() resource.Resource {
+	return &CloudIdentityGroupResource{}
+}
+
+// CloudIdentityGroupResource defines the resource implementation.
+type CloudIdentityGroupResource struct {
+	client *http.Client
+
+	cloudidentityService *cloudidentity.Service
+
+	readRetryTimeout time.Duration
+}
+
+// CloudIdentityGroupResourceModel describes the resource data model.
+type CloudIdentityGroupResourceModel struct {
+	Name                 types.String                            `tfsdk:"name"`
+	GroupKey             types.String                            `tfsdk:"group_key"`
+	Parent               types.String                            `tfsdk:"parent"`
+	DisplayName          types.String                            `tfsdk:"display_name"`
+	Description          types.String                            `tfsdk:"description"`
+	Labels               types.Map                               `tfsdk:"labels"`
+	DynamicGroupMetadata *CloudIdentityGroupDynamicMetadataModel `tfsdk:"dynamic_group_metadata"`
+	Id                   types.String                            `tfsdk:"id"`
+}
+
+// Nested Model for "dynamic_group_metadata".
+type CloudIdentityGroupDynamicMetadataModel struct {
+	Queries []CloudIdentityGroupDynamicQueryModel `tfsdk:"queries"`
+	Status  types.String                          `tfsdk:"status"`
+}
+
+// Nested Model for "queries".
+type CloudIdentityGroupDynamicQueryModel struct {
+	Query        types.String `tfsdk:"query"`
+	ResourceType types.String `tfsdk:"resource_type"`
+}
+
+func (r *CloudIdentityGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_identity_group"
+}
+
+func (r *CloudIdentityGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cloud Identity group resource" + scopesDescription(cloudidentity.CloudIdentityGroupsScope),
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Resource name of the group. Format: groups/{group}.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_key": schema.StringAttribute{
+				MarkdownDescription: "Email address of the group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent": schema.StringAttribute{
+				MarkdownDescription: `Resource name of the entity under which the group is created,
+				in the format 'customers/{customerId}'.`,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the group",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the group",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: `Labels that apply to the group. Defaults to the
+				'cloudidentity.googleapis.com/groups.discussion_forum' label, which makes
+				the group a Google Group.`,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default: mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+					discussionForumLabel: types.StringValue(""),
+				})),
+			},
+			"dynamic_group_metadata": schema.SingleNestedAttribute{
+				MarkdownDescription: `Makes the group a dynamic group, whose members are the users matching
+				its queries. Members of dynamic groups can't be managed. Adding or removing this
+				attribute recreates the group, Google doesn't convert between static and dynamic
+				groups.`,
+				Optional: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+						},
+						"Adding or removing dynamic group metadata requires replacing the group.",
+						"Adding or removing dynamic group metadata requires replacing the group.",
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"queries": schema.ListNestedAttribute{
+						MarkdownDescription: "Membership queries, a user matching any of them is a member",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"query": schema.StringAttribute{
+									MarkdownDescription: `CEL expression that users are matched against, e.g.
+									"user.organizations.exists(org, org.department=='Finance')"`,
+									Required: true,
+									Validators: []validator.String{
+										stringNotBlank(),
+									},
+								},
+								"resource_type": schema.StringAttribute{
+									MarkdownDescription: "Type of the resources the query matches, only USER is supported. Defaults to USER.",
+									Optional:            true,
+									Computed:            true,
+									Default:             stringdefault.StaticString("USER"),
+									Validators: []validator.String{
+										stringOneOf("USER"),
+									},
+								},
+							},
+						},
+					},
+					"status": schema.StringAttribute{
+						MarkdownDescription: "Status of the membership evaluation, e.g. UP_TO_DATE or UPDATING_MEMBERSHIPS",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Group identifier, same as name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CloudIdentityGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.cloudidentityService = pd.cloudidentityService
+	r.readRetryTimeout = pd.readRetryTimeout
+}
+
+func (r *CloudIdentityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CloudIdentityGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group := &cloudidentity.Group{
+		GroupKey:    &cloudidentity.EntityKey{Id: data.GroupKey.ValueString()},
+		Parent:      data.Parent.ValueString(),
+		DisplayName: data.DisplayName.ValueString(),
+		Description: data.Description.ValueString(),
+
+		DynamicGroupMetadata: expandDynamicGroupMetadata(data.DynamicGroupMetadata),
+	}
+	resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &group.Labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op, err := r.cloudidentityService.Groups.Create(group).InitialGroupConfig("EMPTY").Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Cloud Identity Group",
+			fmt.Sprintf("Could not create group %s: %v", data.GroupKey.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	var created cloudidentity.Group
+	done, err := operationResponse(op, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Cloud Identity Group",
+			fmt.Sprintf("Could not create group %s: %v", data.GroupKey.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	// Cloud Identity does not expose its operations for polling, so when the
+	// operation is still running wait for the group to become resolvable.
+	groupName := created.Name
+	if !done || groupName == "" {
+		groupKey := data.GroupKey.ValueString()
+		lookup, err := waitForOperation(ctx, r.readRetryTimeout, func(ctx context.Context) (*cloudidentity.LookupGroupNameResponse, bool, error) {
+			res, err := r.cloudidentityService.Groups.Lookup().GroupKeyId(groupKey).Context(ctx).Do()
+			if isNotFound(err) {
+				return nil, false, nil
+			}
+			return res, err == nil, err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading created Cloud Identity Group",
+				fmt.Sprintf("Group %s was created but could not be looked up: %v", groupKey, formatAPIError(err)),
+			)
+			return
+		}
+		groupName = lookup.Name
+	}
+
+	res, err := readWithRetry(ctx, r.readRetryTimeout, func(ctx context.Context) (*cloudidentity.Group, error) {
+		return r.cloudidentityService.Groups.Get(groupName).Context(ctx).Do()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading created Cloud Identity Group",
+			fmt.Sprintf("Group %s was created but could not be read back: %v", data.GroupKey.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenCloudIdentityGroup(ctx, res, &data)...)
+
+	tflog.Trace(ctx, "Created Cloud Identity Group", map[string]interface{}{
+		"name":      res.Name,
+		"group_key": data.GroupKey.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudIdentityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CloudIdentityGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.cloudidentityService.Groups.Get(data.Name.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Cloud Identity Group no longer exists, removing from state", map[string]interface{}{
+				"name": data.Name.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Cloud Identity Group '%s', got error: %s", data.Name.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenCloudIdentityGroup(ctx, res, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudIdentityGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CloudIdentityGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group := &cloudidentity.Group{
+		DisplayName: data.DisplayName.ValueString(),
+		Description: data.Description.ValueString(),
+		// Clearing the description has to be sent explicitly.
+		ForceSendFields: []string{"DisplayName", "Description"},
+	}
+	resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &group.Labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateMask := "display_name,description,labels"
+	if data.DynamicGroupMetadata != nil {
+		group.DynamicGroupMetadata = expandDynamicGroupMetadata(data.DynamicGroupMetadata)
+		updateMask += ",dynamic_group_metadata"
+	}
+
+	op, err := r.cloudidentityService.Groups.Patch(data.Name.ValueString(), group).
+		UpdateMask(updateMask).
+		Context(ctx).
+		Do()
+	if err == nil {
+		_, err = operationResponse(op, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Cloud Identity Group",
+			fmt.Sprintf("Could not update group %s: %v", data.Name.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	res, err := r.cloudidentityService.Groups.Get(data.Name.ValueString()).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Cloud Identity Group '%s', got error: %s", data.Name.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenCloudIdentityGroup(ctx, res, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudIdentityGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CloudIdentityGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op, err := r.cloudidentityService.Groups.Delete(data.Name.ValueString()).Context(ctx).Do()
+	if err == nil {
+		_, err = operationResponse(op, nil)
+	}
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "Cloud Identity Group already deleted", map[string]interface{}{
+				"name": data.Name.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Cloud Identity Group",
+			fmt.Sprintf("Could not delete group %s: %v", data.Name.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *CloudIdentityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !strings.HasPrefix(req.ID, "groups/") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a group resource name in the format groups/{group}, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func flattenCloudIdentityGroup(ctx context.Context, g *cloudidentity.Group, data *CloudIdentityGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringValue(g.Name)
+	data.Id = types.StringValue(g.Name)
+	data.Parent = types.StringValue(g.Parent)
+	if g.GroupKey != nil {
+		data.GroupKey = types.StringValue(g.GroupKey.Id)
+	}
+
+	data.DisplayName = types.StringValue(g.DisplayName)
+
+	// Keep an unset description null rather than an empty string.
+	if g.Description != "" || !data.Description.IsNull() {
+		data.Description = types.StringValue(g.Description)
+	}
+
+	data.DynamicGroupMetadata = flattenDynamicGroupMetadata(g.DynamicGroupMetadata)
+
+	labels, d := types.MapValueFrom(ctx, types.StringType, g.Labels)
+	diags.Append(d...)
+	data.Labels = labels
+
+	return diags
+}
+
+func expandDynamicGroupMetadata(m *CloudIdentityGroupDynamicMetadataModel) *cloudidentity.DynamicGroupMetadata {
+	if m == nil {
+		return nil
+	}
+
+	metadata := &cloudidentity.DynamicGroupMetadata{}
+	for _, q := range m.Queries {
+		metadata.Queries = append(metadata.Queries, &cloudidentity.DynamicGroupQuery{
+			Query:        q.Query.ValueString(),
+			ResourceType: q.ResourceType.ValueString(),
+		})
+	}
+
+	return metadata
+}
+
+func flattenDynamicGroupMetadata(metadata *cloudidentity.DynamicGroupMetadata) *CloudIdentityGroupDynamicMetadataModel {
+	if metadata == nil || len(metadata.Queries) == 0 {
+		return nil
+	}
+
+	m := &CloudIdentityGroupDynamicMetadataModel{
+		Queries: make([]CloudIdentityGroupDynamicQueryModel, 0, len(metadata.Queries)),
+		Status:  types.StringNull(),
+	}
+	for _, q := range metadata.Queries {
+		m.Queries = append(m.Queries, CloudIdentityGroupDynamicQueryModel{
+			Query:        types.StringValue(q.Query),
+			ResourceType: types.StringValue(q.ResourceType),
+		})
+	}
+	if metadata.Status != nil {
+		m.Status = stringOrNull(metadata.Status.Status)
+	}
+
+	return m
+}
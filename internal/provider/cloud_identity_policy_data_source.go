@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -14,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"google.golang.org/api/cloudidentity/v1"
-	"google.golang.org/api/option"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -146,25 +144,19 @@ func (d *CloudIdentityPolicyDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	client, ok := req.ProviderData.(*http.Client)
+	pd, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
-	srv, err := cloudidentity.NewService(ctx, option.WithHTTPClient(client))
-	if err != nil {
-		log.Fatalf("Unable to retrieve directory Client %v", err)
-	}
-
-	d.cloudidentityService = srv
-
+	d.client = pd.client
+	d.cloudidentityService = pd.cloudidentityService
 }
 
 func (d *CloudIdentityPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -182,7 +174,7 @@ func (d *CloudIdentityPolicyDataSource) Read(ctx context.Context, req datasource
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
-			fmt.Sprintf("Unable to read Cloud Identity Policy '%s': %s", policyName, err),
+			fmt.Sprintf("Unable to read Cloud Identity Policy '%s': %s", policyName, formatAPIError(err)),
 		)
 		return
 	}
@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cloudidentitybeta "google.golang.org/api/cloudidentity/v1beta1"
+	"google.golang.org/api/googleapi"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudIdentityPolicyResource{}
+var _ resource.ResourceWithImportState = &CloudIdentityPolicyResource{}
+
+func NewCloudIdentityPolicyResource() resource.Resource {
+	return &CloudIdentityPolicyResource{}
+}
+
+// CloudIdentityPolicyResource defines the resource implementation. Policies
+// can only be patched through the v1beta1 API.
+type CloudIdentityPolicyResource struct {
+	client *http.Client
+
+	cloudidentityService *cloudidentitybeta.Service
+}
+
+// CloudIdentityPolicyResourceModel describes the resource data model.
+type CloudIdentityPolicyResourceModel struct {
+	Name     types.String  `tfsdk:"name"`
+	Customer types.String  `tfsdk:"customer"`
+	Type     types.String  `tfsdk:"type"`
+	Query    *QueryModel   `tfsdk:"query"`
+	Setting  *SettingModel `tfsdk:"setting"`
+	Id       types.String  `tfsdk:"id"`
+}
+
+func (r *CloudIdentityPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_identity_policy"
+}
+
+func (r *CloudIdentityPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Cloud Identity Policy resource. Policies cannot be created or deleted,
+		creating this resource adopts an existing admin-configurable policy and
+		destroying it only removes the policy from state.`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: `Identifier. The resource name
+				(https://cloud.google.com/apis/design/resource_names)
+				of the Policy. Format: policies/{policy}.`,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"customer": schema.StringAttribute{
+				MarkdownDescription: `Customer that the Policy belongs to, in the format
+				'customers/{customerId}'.`,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the policy, SYSTEM or ADMIN",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query": schema.SingleNestedAttribute{
+				MarkdownDescription: "The Policy Query",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"group": schema.StringAttribute{
+						MarkdownDescription: "The group the query applies to, if it applies to a single group",
+						Computed:            true,
+					},
+					"org_unit": schema.StringAttribute{
+						MarkdownDescription: "The OrgUnit the query applies to, if it applies to a single OrgUnit",
+						Computed:            true,
+					},
+					"query": schema.StringAttribute{
+						MarkdownDescription: "The CEL query that defines which entities the Policy applies to",
+						Computed:            true,
+					},
+				},
+			},
+			"setting": schema.SingleNestedAttribute{
+				MarkdownDescription: "The Policy Setting",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: `The type of the Setting.`,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: `The value of the Setting, as a JSON object.`,
+						Required:            true,
+						Validators: []validator.String{
+							stringIsJSON(),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CloudIdentityPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.cloudidentityService = pd.cloudidentityBetaService
+}
+
+// Create adopts the existing policy and applies the configured setting value.
+func (r *CloudIdentityPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CloudIdentityPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Adopted Cloud Identity Policy", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudIdentityPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CloudIdentityPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.cloudidentityService.Policies.Get(data.Name.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Cloud Identity Policy no longer exists, removing from state", map[string]interface{}{
+				"name": data.Name.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Cloud Identity Policy '%s': %s", data.Name.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenCloudIdentityPolicy(policy, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudIdentityPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CloudIdentityPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the policy from state, customers cannot delete
+// policies.
+func (r *CloudIdentityPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CloudIdentityPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "Cloud Identity Policies cannot be deleted, removing from state only", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+}
+
+func (r *CloudIdentityPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// apply reads the policy named in data, patches its setting value when it
+// differs from the planned value, and stores the result in data.
+func (r *CloudIdentityPolicyResource) apply(ctx context.Context, data *CloudIdentityPolicyResourceModel, diags *diag.Diagnostics) {
+	name := data.Name.ValueString()
+	value := data.Setting.Value.ValueString()
+
+	policy, err := r.cloudidentityService.Policies.Get(name).Context(ctx).Do()
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Cloud Identity Policy '%s': %s", name, formatAPIError(err)),
+		)
+		return
+	}
+
+	if policy.Setting == nil || !jsonEqual(string(policy.Setting.Value), value) {
+		patch := &cloudidentitybeta.Policy{
+			Customer:    policy.Customer,
+			PolicyQuery: policy.PolicyQuery,
+			Setting: &cloudidentitybeta.Setting{
+				Value: googleapi.RawMessage(value),
+			},
+		}
+		if policy.Setting != nil {
+			patch.Setting.Type = policy.Setting.Type
+		}
+
+		op, err := r.cloudidentityService.Policies.Patch(name, patch).Context(ctx).Do()
+		if err == nil && op.Done && op.Error != nil {
+			err = &operationError{Name: op.Name, Code: op.Error.Code, Message: op.Error.Message}
+		}
+		if err != nil {
+			diags.AddError(
+				"Error Updating Cloud Identity Policy",
+				fmt.Sprintf("Could not update policy %s: %v", name, formatAPIError(err)),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, "Updated Cloud Identity Policy setting", map[string]interface{}{
+			"name": name,
+		})
+
+		// The patch may still be in progress, record the value that was
+		// applied rather than reading back a stale one.
+		if policy.Setting == nil {
+			policy.Setting = &cloudidentitybeta.Setting{}
+		}
+		policy.Setting.Value = googleapi.RawMessage(value)
+	}
+
+	flattenCloudIdentityPolicy(policy, data)
+}
+
+// flattenCloudIdentityPolicy stores policy in data. A setting value that is
+// equivalent to the one already in data is kept as is, so that Google
+// reformatting the JSON does not cause a diff.
+func flattenCloudIdentityPolicy(policy *cloudidentitybeta.Policy, data *CloudIdentityPolicyResourceModel) {
+	data.Id = types.StringValue(policy.Name)
+	data.Name = types.StringValue(policy.Name)
+	data.Customer = types.StringValue(policy.Customer)
+	data.Type = types.StringValue(policy.Type)
+
+	data.Query = nil
+	if policy.PolicyQuery != nil {
+		data.Query = &QueryModel{
+			Group:   types.StringValue(policy.PolicyQuery.Group),
+			OrgUnit: types.StringValue(policy.PolicyQuery.OrgUnit),
+			Query:   types.StringValue(policy.PolicyQuery.Query),
+		}
+	}
+
+	if policy.Setting == nil {
+		data.Setting = nil
+		return
+	}
+
+	value := string(policy.Setting.Value)
+	if data.Setting != nil && jsonEqual(data.Setting.Value.ValueString(), value) {
+		value = data.Setting.Value.ValueString()
+	}
+
+	data.Setting = &SettingModel{
+		Type:  types.StringValue(policy.Setting.Type),
+		Value: types.StringValue(value),
+	}
+}
+
+// jsonEqual reports whether a and b hold semantically equal JSON documents.
+func jsonEqual(a, b string) bool {
+	var va, vb interface{}
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
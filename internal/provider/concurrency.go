@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// defaultMaxConcurrency bounds how many requests a resource sends at once
+// when applying many changes, unless the max_concurrency provider attribute
+// is set.
+const defaultMaxConcurrency = 8
+
+// runConcurrently calls f for every item, with at most limit calls running at
+// once, and returns the diagnostics of all calls. Items that haven't started
+// when ctx is cancelled are skipped. Rate limits are left to the retry
+// transport of the client f uses.
+func runConcurrently[T any](ctx context.Context, limit int, items []T, f func(context.Context, T) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	if limit < 1 {
+		limit = 1
+	}
+	sem := make(chan struct{}, limit)
+
+	for _, item := range items {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(item T) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			d := f(ctx, item)
+
+			mu.Lock()
+			diags.Append(d...)
+			mu.Unlock()
+		}(item)
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		diags.AddError("Operation Cancelled", err.Error())
+	}
+
+	return diags
+}
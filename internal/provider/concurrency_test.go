@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	tests := map[string]struct {
+		limit   int
+		items   int
+		wantMax int32
+	}{
+		"bounded by limit": {
+			limit:   3,
+			items:   10,
+			wantMax: 3,
+		},
+		"zero limit runs one at a time": {
+			limit:   0,
+			items:   4,
+			wantMax: 1,
+		},
+		"fewer items than limit": {
+			limit:   8,
+			items:   2,
+			wantMax: 2,
+		},
+		"no items": {
+			limit: 8,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			items := make([]int, test.items)
+			for i := range items {
+				items[i] = i
+			}
+
+			var running, maxRunning, calls atomic.Int32
+			diags := runConcurrently(context.Background(), test.limit, items, func(ctx context.Context, item int) diag.Diagnostics {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					m := maxRunning.Load()
+					if n <= m || maxRunning.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				calls.Add(1)
+				time.Sleep(10 * time.Millisecond)
+
+				var diags diag.Diagnostics
+				diags.AddError("Error", fmt.Sprintf("item %d", item))
+				return diags
+			})
+
+			if got := int(calls.Load()); got != test.items {
+				t.Errorf("got %d calls, want %d", got, test.items)
+			}
+			if got := maxRunning.Load(); got != test.wantMax {
+				t.Errorf("got %d calls running at once, want %d", got, test.wantMax)
+			}
+			if got := diags.ErrorsCount(); got != test.items {
+				t.Errorf("got %d errors, want one per item: %v", got, diags)
+			}
+		})
+	}
+}
+
+func TestRunConcurrentlyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int32
+	diags := runConcurrently(ctx, 1, []int{1, 2, 3}, func(ctx context.Context, item int) diag.Diagnostics {
+		calls.Add(1)
+		cancel()
+		return nil
+	})
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("got %d calls, want the items after cancelling to be skipped", got)
+	}
+	if !diags.HasError() {
+		t.Errorf("got no error, want the cancellation to be reported")
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"cloud.google.com/go/compute/metadata"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+)
+
+// serviceAccountTokenSource returns a token source that acts as subject
+// through domain-wide delegation. credentials is either the service account
+// key JSON itself or the path to a file containing it.
+func serviceAccountTokenSource(ctx context.Context, credentials, subject string, scopes []string) (oauth2.TokenSource, error) {
+	if json.Valid([]byte(credentials)) {
+		return jwtTokenSource(ctx, []byte(credentials), subject, scopes)
+	}
+
+	b, err := os.ReadFile(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("credentials are neither valid JSON nor a readable file: %w", err)
+	}
+
+	return jwtTokenSource(ctx, b, subject, scopes)
+}
+
+// defaultTokenSource returns a token source that acts as subject through
+// domain-wide delegation, using Application Default Credentials.
+//
+// When ADC resolves to a service account key, the key signs the delegated JWT
+// directly. Otherwise (e.g. Workload Identity on GKE or the GCE metadata
+// server) there is no key material, and the JWT is signed through the IAM
+// Credentials API on behalf of the attached service account, which therefore
+// needs the Service Account Token Creator role on itself.
+func defaultTokenSource(ctx context.Context, subject string, scopes []string) (oauth2.TokenSource, error) {
+	creds, err := google.FindDefaultCredentials(ctx, scopes...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find Application Default Credentials: %w", err)
+	}
+
+	if len(creds.JSON) > 0 {
+		return jwtTokenSource(ctx, creds.JSON, subject, scopes)
+	}
+
+	email, err := metadata.EmailWithContext(ctx, "default")
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine the service account of Application Default Credentials: %w", err)
+	}
+
+	ts, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+		TargetPrincipal: email,
+		Scopes:          scopes,
+		Subject:         subject,
+	}, option.WithTokenSource(creds.TokenSource))
+	if err != nil {
+		return nil, fmt.Errorf("unable to impersonate %s as service account %s: %w", subject, email, err)
+	}
+
+	return ts, nil
+}
+
+// jwtTokenSource parses a service account key and returns a token source
+// acting as subject.
+func jwtTokenSource(ctx context.Context, key []byte, subject string, scopes []string) (oauth2.TokenSource, error) {
+	config, err := google.JWTConfigFromJSON(key, scopes...)
+	if err != nil {
+		return nil, fmt.Errorf("credentials are not a valid service account key: %w", err)
+	}
+
+	// CRITICAL: Set the Subject (Domain-Wide Delegation)
+	// This explicitly tells Google: "I am this Service Account, but I want to act as THIS user."
+	config.Subject = subject
+
+	return config.TokenSource(ctx), nil
+}
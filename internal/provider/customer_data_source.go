@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CustomerDataSource{}
+
+func NewCustomerDataSource() datasource.DataSource {
+	return &CustomerDataSource{}
+}
+
+// CustomerDataSource defines the data source implementation.
+type CustomerDataSource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// CustomerDataSourceModel describes the data source data model.
+type CustomerDataSourceModel struct {
+	CustomerId           types.String                `tfsdk:"customer_id"`
+	CustomerDomain       types.String                `tfsdk:"customer_domain"`
+	AlternateEmail       types.String                `tfsdk:"alternate_email"`
+	PhoneNumber          types.String                `tfsdk:"phone_number"`
+	Language             types.String                `tfsdk:"language"`
+	CustomerCreationTime types.String                `tfsdk:"customer_creation_time"`
+	PostalAddress        *CustomerPostalAddressModel `tfsdk:"postal_address"`
+	Id                   types.String                `tfsdk:"id"`
+}
+
+// Nested Model for "postal_address".
+type CustomerPostalAddressModel struct {
+	ContactName      types.String `tfsdk:"contact_name"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	AddressLine1     types.String `tfsdk:"address_line1"`
+	AddressLine2     types.String `tfsdk:"address_line2"`
+	AddressLine3     types.String `tfsdk:"address_line3"`
+	Locality         types.String `tfsdk:"locality"`
+	Region           types.String `tfsdk:"region"`
+	PostalCode       types.String `tfsdk:"postal_code"`
+	CountryCode      types.String `tfsdk:"country_code"`
+}
+
+func (d *CustomerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer"
+}
+
+func (d *CustomerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `The Google Workspace customer the provider manages, i.e. the customer of
+		the impersonated user unless the provider customer_id is set.` + scopesDescription(admin.AdminDirectoryCustomerReadonlyScope),
+
+		Attributes: map[string]schema.Attribute{
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the customer, e.g. 'C01abc23d'",
+				Computed:            true,
+			},
+			"customer_domain": schema.StringAttribute{
+				MarkdownDescription: "The customer's primary domain",
+				Computed:            true,
+			},
+			"alternate_email": schema.StringAttribute{
+				MarkdownDescription: "The customer's secondary contact email address, null when not set",
+				Computed:            true,
+			},
+			"phone_number": schema.StringAttribute{
+				MarkdownDescription: "The customer's contact phone number in E.164 format, null when not set",
+				Computed:            true,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "The customer's ISO 639-2 language code, null when not set",
+				Computed:            true,
+			},
+			"customer_creation_time": schema.StringAttribute{
+				MarkdownDescription: "The time the customer was created",
+				Computed:            true,
+			},
+			"postal_address": schema.SingleNestedAttribute{
+				MarkdownDescription: "The customer's postal address",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"contact_name": schema.StringAttribute{
+						MarkdownDescription: "The customer contact's name",
+						Computed:            true,
+					},
+					"organization_name": schema.StringAttribute{
+						MarkdownDescription: "The company or company division name",
+						Computed:            true,
+					},
+					"address_line1": schema.StringAttribute{
+						MarkdownDescription: "First line of the address",
+						Computed:            true,
+					},
+					"address_line2": schema.StringAttribute{
+						MarkdownDescription: "Second line of the address",
+						Computed:            true,
+					},
+					"address_line3": schema.StringAttribute{
+						MarkdownDescription: "Third line of the address",
+						Computed:            true,
+					},
+					"locality": schema.StringAttribute{
+						MarkdownDescription: "Name of the locality, e.g. the town or city",
+						Computed:            true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Name of the region, e.g. the state or province",
+						Computed:            true,
+					},
+					"postal_code": schema.StringAttribute{
+						MarkdownDescription: "The postal code",
+						Computed:            true,
+					},
+					"country_code": schema.StringAttribute{
+						MarkdownDescription: "ISO 3166 country code",
+						Computed:            true,
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Customer identifier, same as customer_id",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CustomerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.adminService = pd.adminService
+}
+
+func (d *CustomerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CustomerDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := d.adminService.Customers.Get(d.customerId).Context(ctx).Do()
+	if err != nil {
+		if isForbidden(err) {
+			resp.Diagnostics.AddError(
+				"Insufficient Permissions",
+				fmt.Sprintf("The impersonated user is not allowed to read customer '%s'. It needs an admin role "+
+					"with the customer read privilege, and the %s scope must be granted: %s",
+					d.customerId, admin.AdminDirectoryCustomerReadonlyScope, formatAPIError(err)),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read customer '%s', got error: %s", d.customerId, formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenCustomer(c, &data)
+
+	tflog.Trace(ctx, "read customer", map[string]interface{}{
+		"customer_id": c.Id,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenCustomer copies the API representation of a customer into the
+// model. Contact details the customer didn't set are null.
+func flattenCustomer(c *admin.Customer, data *CustomerDataSourceModel) {
+	data.Id = types.StringValue(c.Id)
+	data.CustomerId = types.StringValue(c.Id)
+	data.CustomerDomain = types.StringValue(c.CustomerDomain)
+	data.AlternateEmail = stringOrNull(c.AlternateEmail)
+	data.PhoneNumber = stringOrNull(c.PhoneNumber)
+	data.Language = stringOrNull(c.Language)
+	data.CustomerCreationTime = types.StringValue(c.CustomerCreationTime)
+
+	data.PostalAddress = nil
+	if a := c.PostalAddress; a != nil {
+		data.PostalAddress = &CustomerPostalAddressModel{
+			ContactName:      types.StringValue(a.ContactName),
+			OrganizationName: types.StringValue(a.OrganizationName),
+			AddressLine1:     types.StringValue(a.AddressLine1),
+			AddressLine2:     types.StringValue(a.AddressLine2),
+			AddressLine3:     types.StringValue(a.AddressLine3),
+			Locality:         types.StringValue(a.Locality),
+			Region:           types.StringValue(a.Region),
+			PostalCode:       types.StringValue(a.PostalCode),
+			CountryCode:      types.StringValue(a.CountryCode),
+		}
+	}
+}
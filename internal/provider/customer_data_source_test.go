@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+func TestFlattenCustomer(t *testing.T) {
+	tests := map[string]struct {
+		customer           *admin.Customer
+		wantAlternateEmail types.String
+		wantPhoneNumber    types.String
+		wantLanguage       types.String
+	}{
+		"contact details set": {
+			customer: &admin.Customer{
+				Id:             "C01",
+				AlternateEmail: "it@example.org",
+				PhoneNumber:    "+15555550100",
+				Language:       "en",
+			},
+			wantAlternateEmail: types.StringValue("it@example.org"),
+			wantPhoneNumber:    types.StringValue("+15555550100"),
+			wantLanguage:       types.StringValue("en"),
+		},
+		"empty phone number": {
+			customer: &admin.Customer{
+				Id:             "C01",
+				AlternateEmail: "it@example.org",
+				Language:       "en",
+			},
+			wantAlternateEmail: types.StringValue("it@example.org"),
+			wantPhoneNumber:    types.StringNull(),
+			wantLanguage:       types.StringValue("en"),
+		},
+		"no contact details": {
+			customer:           &admin.Customer{Id: "C01"},
+			wantAlternateEmail: types.StringNull(),
+			wantPhoneNumber:    types.StringNull(),
+			wantLanguage:       types.StringNull(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var data CustomerDataSourceModel
+			flattenCustomer(test.customer, &data)
+
+			if !data.AlternateEmail.Equal(test.wantAlternateEmail) {
+				t.Errorf("got alternate_email %s, want %s", data.AlternateEmail, test.wantAlternateEmail)
+			}
+			if !data.PhoneNumber.Equal(test.wantPhoneNumber) {
+				t.Errorf("got phone_number %s, want %s", data.PhoneNumber, test.wantPhoneNumber)
+			}
+			if !data.Language.Equal(test.wantLanguage) {
+				t.Errorf("got language %s, want %s", data.Language, test.wantLanguage)
+			}
+			if data.PostalAddress != nil {
+				t.Errorf("got postal_address %+v, want none", data.PostalAddress)
+			}
+		})
+	}
+}
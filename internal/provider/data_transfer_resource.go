@@ -0,0 +1,383 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	datatransfer "google.golang.org/api/admin/datatransfer/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DataTransferResource{}
+var _ resource.ResourceWithImportState = &DataTransferResource{}
+
+func NewDataTransferResource() resource.Resource {
+	return &DataTransferResource{}
+}
+
+// defaultDataTransferTimeout bounds how long Create waits for a transfer to
+// finish. Transfers of large Drives can take hours, in which case the timeout
+// has to be raised.
+const defaultDataTransferTimeout = 30 * time.Minute
+
+// DataTransferResource defines the resource implementation.
+type DataTransferResource struct {
+	client *http.Client
+
+	datatransferService *datatransfer.Service
+}
+
+// DataTransferResourceModel describes the resource data model.
+type DataTransferResourceModel struct {
+	OldOwnerUserId           types.String                                   `tfsdk:"old_owner_user_id"`
+	NewOwnerUserId           types.String                                   `tfsdk:"new_owner_user_id"`
+	ApplicationDataTransfers []DataTransferResourceApplicationTransferModel `tfsdk:"application_data_transfers"`
+	Timeout                  types.String                                   `tfsdk:"timeout"`
+	Status                   types.String                                   `tfsdk:"status"`
+	RequestTime              types.String                                   `tfsdk:"request_time"`
+	Id                       types.String                                   `tfsdk:"id"`
+}
+
+// Nested Model for "application_data_transfers".
+type DataTransferResourceApplicationTransferModel struct {
+	ApplicationId types.Int64                              `tfsdk:"application_id"`
+	Params        []DataTransferResourceTransferParamModel `tfsdk:"params"`
+}
+
+// Nested Model for "application_data_transfers.params".
+type DataTransferResourceTransferParamModel struct {
+	Key    types.String   `tfsdk:"key"`
+	Values []types.String `tfsdk:"values"`
+}
+
+func (r *DataTransferResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_transfer"
+}
+
+func (r *DataTransferResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Transfer of the ownership of a user's data, e.g. Drive files or
+		Calendar events, to another user, typically when offboarding. Creating the resource
+		starts the transfer and waits for it to complete. Transfers cannot be undone, so
+		deleting the resource only removes it from state.` + scopesDescription(datatransfer.AdminDatatransferScope),
+
+		Attributes: map[string]schema.Attribute{
+			"old_owner_user_id": schema.StringAttribute{
+				MarkdownDescription: "Unique ID of the user whose data is transferred",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"new_owner_user_id": schema.StringAttribute{
+				MarkdownDescription: "Unique ID of the user the data is transferred to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_data_transfers": schema.ListNestedAttribute{
+				MarkdownDescription: `Applications to transfer data of. The application IDs are listed by
+				the googleworkspace_transfer_applications data source.`,
+				Required: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"application_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the application",
+							Required:            true,
+						},
+						"params": schema.ListNestedAttribute{
+							MarkdownDescription: `Transfer parameters selecting the data to transfer, e.g. key
+							'PRIVACY_LEVEL' with values 'SHARED' and 'PRIVATE' for Drive.`,
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										MarkdownDescription: "The parameter key",
+										Required:            true,
+									},
+									"values": schema.ListAttribute{
+										MarkdownDescription: "The parameter values",
+										ElementType:         types.StringType,
+										Required:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: `How long to wait for the transfer to complete, as a duration string
+				such as '30m' or '2h'. Defaults to '30m'. The transfer continues in Google Workspace
+				when the timeout is reached.`,
+				Optional: true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Overall status of the transfer, e.g. inProgress, completed or failed",
+				Computed:            true,
+			},
+			"request_time": schema.StringAttribute{
+				MarkdownDescription: "The time the transfer was requested, in RFC 3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Transfer identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DataTransferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.datatransferService = pd.datatransferService
+}
+
+func (r *DataTransferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DataTransferResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultDataTransferTimeout
+	if !data.Timeout.IsNull() {
+		d, err := time.ParseDuration(data.Timeout.ValueString())
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid Timeout",
+				fmt.Sprintf("The timeout must be a positive duration string such as '30m', got: %s", data.Timeout.ValueString()),
+			)
+			return
+		}
+		timeout = d
+	}
+
+	res, err := r.datatransferService.Transfers.Insert(expandDataTransfer(&data)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Data Transfer",
+			fmt.Sprintf("Could not transfer data of user %s to user %s: %v",
+				data.OldOwnerUserId.ValueString(), data.NewOwnerUserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created Data Transfer", map[string]interface{}{
+		"id": res.Id,
+	})
+
+	res, err = waitForOperation(ctx, timeout, func(ctx context.Context) (*datatransfer.DataTransfer, bool, error) {
+		t, done, err := r.pollDataTransfer(ctx, res)
+		res = t
+		return t, done, err
+	})
+
+	// Keep the transfer in state even when waiting failed, Terraform then
+	// marks it as tainted rather than losing track of it.
+	flattenDataTransfer(res, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for Data Transfer",
+			fmt.Sprintf("Transfer %s did not complete: %v", res.Id, formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *DataTransferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DataTransferResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.datatransferService.Transfers.Get(data.Id.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Data Transfer no longer exists in Google Workspace, removing from state", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read data transfer '%s', got error: %s", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenDataTransfer(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only stores timeout, every other attribute requires replacement.
+func (r *DataTransferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DataTransferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Status = state.Status
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete does not call Google, a transfer cannot be reverted.
+func (r *DataTransferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DataTransferResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "Data Transfers cannot be reverted, removing from state only", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *DataTransferResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// pollDataTransfer reads the current state of transfer t and reports whether
+// it completed. On error t is returned, so the caller can still store it.
+func (r *DataTransferResource) pollDataTransfer(ctx context.Context, t *datatransfer.DataTransfer) (*datatransfer.DataTransfer, bool, error) {
+	res, err := r.datatransferService.Transfers.Get(t.Id).Context(ctx).Do()
+	if err != nil {
+		return t, false, err
+	}
+
+	switch res.OverallTransferStatusCode {
+	case "completed":
+		return res, true, nil
+	case "failed":
+		return res, true, &operationError{Name: res.Id, Message: "the transfer failed, check the Admin console for details"}
+	}
+
+	tflog.Debug(ctx, "Data Transfer not finished yet", map[string]interface{}{
+		"id":     res.Id,
+		"status": res.OverallTransferStatusCode,
+	})
+
+	return res, false, nil
+}
+
+func expandDataTransfer(data *DataTransferResourceModel) *datatransfer.DataTransfer {
+	t := &datatransfer.DataTransfer{
+		OldOwnerUserId: data.OldOwnerUserId.ValueString(),
+		NewOwnerUserId: data.NewOwnerUserId.ValueString(),
+	}
+
+	for _, a := range data.ApplicationDataTransfers {
+		adt := &datatransfer.ApplicationDataTransfer{
+			ApplicationId: a.ApplicationId.ValueInt64(),
+		}
+		for _, p := range a.Params {
+			param := &datatransfer.ApplicationTransferParam{
+				Key: p.Key.ValueString(),
+			}
+			for _, v := range p.Values {
+				param.Value = append(param.Value, v.ValueString())
+			}
+			adt.ApplicationTransferParams = append(adt.ApplicationTransferParams, param)
+		}
+		t.ApplicationDataTransfers = append(t.ApplicationDataTransfers, adt)
+	}
+
+	return t
+}
+
+// flattenDataTransfer stores t in data. The configured applications are kept
+// as they are, since Google may add defaults to the transfer parameters; they
+// are only taken from t after an import.
+func flattenDataTransfer(t *datatransfer.DataTransfer, data *DataTransferResourceModel) {
+	data.Id = types.StringValue(t.Id)
+	data.OldOwnerUserId = types.StringValue(t.OldOwnerUserId)
+	data.NewOwnerUserId = types.StringValue(t.NewOwnerUserId)
+	data.Status = types.StringValue(t.OverallTransferStatusCode)
+	data.RequestTime = types.StringValue(t.RequestTime)
+
+	if data.ApplicationDataTransfers != nil {
+		return
+	}
+
+	data.ApplicationDataTransfers = make([]DataTransferResourceApplicationTransferModel, 0, len(t.ApplicationDataTransfers))
+	for _, a := range t.ApplicationDataTransfers {
+		adt := DataTransferResourceApplicationTransferModel{
+			ApplicationId: types.Int64Value(a.ApplicationId),
+		}
+		for _, p := range a.ApplicationTransferParams {
+			param := DataTransferResourceTransferParamModel{
+				Key:    types.StringValue(p.Key),
+				Values: make([]types.String, 0, len(p.Value)),
+			}
+			for _, v := range p.Value {
+				param.Values = append(param.Values, types.StringValue(v))
+			}
+			adt.Params = append(adt.Params, param)
+		}
+		data.ApplicationDataTransfers = append(data.ApplicationDataTransfers, adt)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DirectoryQueryFunction{}
+
+func NewDirectoryQueryFunction() function.Function {
+	return &DirectoryQueryFunction{}
+}
+
+// DirectoryQueryFunction defines the function implementation.
+type DirectoryQueryFunction struct{}
+
+// directoryQueryFieldPattern matches directory search fields, including
+// custom schema fields in the form schemaName.fieldName.
+var directoryQueryFieldPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(\.[A-Za-z][A-Za-z0-9_]*)?$`)
+
+// directoryQueryOperators are the operators supported by directory searches.
+var directoryQueryOperators = []string{"=", ":", "<", "<=", ">", ">="}
+
+// directoryQuery builds a single directory search clause such as
+// orgUnitPath='/A/B'. The value is always quoted, with embedded backslashes
+// and single quotes escaped, so values containing spaces or quotes are
+// matched literally.
+func directoryQuery(field, op, value string) (string, error) {
+	if !directoryQueryFieldPattern.MatchString(field) {
+		return "", fmt.Errorf("invalid field name %q", field)
+	}
+
+	validOp := false
+	for _, o := range directoryQueryOperators {
+		if op == o {
+			validOp = true
+			break
+		}
+	}
+	if !validOp {
+		return "", fmt.Errorf("invalid operator %q, must be one of: %s", op, strings.Join(directoryQueryOperators, " "))
+	}
+
+	escaped := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+
+	return fmt.Sprintf("%s%s'%s'", field, op, escaped), nil
+}
+
+func (f *DirectoryQueryFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "directory_query"
+}
+
+func (f *DirectoryQueryFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an escaped directory search clause",
+		MarkdownDescription: `Builds a single clause for the 'query' of directory list calls,
+		e.g. 'directory_query("orgUnitPath", "=", "/A/B")' returns "orgUnitPath='/A/B'".
+		The value is quoted and single quotes and backslashes in it are escaped.
+		See https://developers.google.com/admin-sdk/directory/v1/guides/search-users`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "field",
+				MarkdownDescription: "Field to search on, e.g. 'orgUnitPath' or 'schemaName.fieldName'",
+			},
+			function.StringParameter{
+				Name:                "op",
+				MarkdownDescription: "Operator, one of '=', ':', '<', '<=', '>' or '>='",
+			},
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "Value to compare against",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DirectoryQueryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var field, op, value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &field, &op, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	query, err := directoryQuery(field, op, value)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, query))
+}
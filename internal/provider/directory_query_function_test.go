@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDirectoryQueryFunction(t *testing.T) {
+	tests := map[string]struct {
+		field   string
+		op      string
+		value   string
+		want    string
+		wantErr *function.FuncError
+	}{
+		"equals": {
+			field: "orgUnitPath",
+			op:    "=",
+			value: "/Sales/EMEA",
+			want:  "orgUnitPath='/Sales/EMEA'",
+		},
+		"prefix match": {
+			field: "email",
+			op:    ":",
+			value: "jdoe*",
+			want:  "email:'jdoe*'",
+		},
+		"comparison": {
+			field: "lastLoginTime",
+			op:    ">=",
+			value: "2024-01-01",
+			want:  "lastLoginTime>='2024-01-01'",
+		},
+		"custom schema field": {
+			field: "EmployeeInfo.costCenter",
+			op:    "=",
+			value: "1234",
+			want:  "EmployeeInfo.costCenter='1234'",
+		},
+		"empty value": {
+			field: "name",
+			op:    ":",
+			value: "",
+			want:  "name:''",
+		},
+		"whitespace is kept": {
+			field: "name",
+			op:    ":",
+			value: " Sales Team ",
+			want:  "name:' Sales Team '",
+		},
+		"quotes and backslashes are escaped": {
+			field: "name",
+			op:    "=",
+			value: `O'Brien\Sales`,
+			want:  `name='O\'Brien\\Sales'`,
+		},
+		"empty field": {
+			field:   "",
+			op:      "=",
+			value:   "x",
+			wantErr: function.NewFuncError(`invalid field name ""`),
+		},
+		"field with spaces": {
+			field:   "org unit",
+			op:      "=",
+			value:   "x",
+			wantErr: function.NewFuncError(`invalid field name "org unit"`),
+		},
+		"nested custom schema field": {
+			field:   "a.b.c",
+			op:      "=",
+			value:   "x",
+			wantErr: function.NewFuncError(`invalid field name "a.b.c"`),
+		},
+		"invalid operator": {
+			field:   "name",
+			op:      "!=",
+			value:   "x",
+			wantErr: function.NewFuncError(`invalid operator "!=", must be one of: = : < <= > >=`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runTestFunction(t, NewDirectoryQueryFunction(), types.StringValue(test.field), types.StringValue(test.op), types.StringValue(test.value))
+			if !err.Equal(test.wantErr) {
+				t.Fatalf("got error %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if want := types.StringValue(test.want); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DomainAliasesDataSource{}
+
+func NewDomainAliasesDataSource() datasource.DataSource {
+	return &DomainAliasesDataSource{}
+}
+
+// DomainAliasesDataSource defines the data source implementation.
+type DomainAliasesDataSource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// DomainAliasesDataSourceModel describes the data source data model.
+type DomainAliasesDataSourceModel struct {
+	Customer         types.String                         `tfsdk:"customer"`
+	ParentDomainName types.String                         `tfsdk:"parent_domain_name"`
+	DomainAliases    []DomainAliasesDataSourceDomainAlias `tfsdk:"domain_aliases"`
+	Id               types.String                         `tfsdk:"id"`
+}
+
+// Nested Model for "domain_aliases".
+type DomainAliasesDataSourceDomainAlias struct {
+	DomainAliasName  types.String `tfsdk:"domain_alias_name"`
+	ParentDomainName types.String `tfsdk:"parent_domain_name"`
+	Verified         types.Bool   `tfsdk:"verified"`
+	CreationTime     types.String `tfsdk:"creation_time"`
+}
+
+func (d *DomainAliasesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_aliases"
+}
+
+func (d *DomainAliasesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the domain aliases of a customer" + scopesDescription(admin.AdminDirectoryDomainScope),
+
+		Attributes: map[string]schema.Attribute{
+			"customer": schema.StringAttribute{
+				MarkdownDescription: `The unique ID for the customer's Google Workspace account. Defaults to
+				the provider customer_id.`,
+				Optional: true,
+				Computed: true,
+			},
+			"parent_domain_name": schema.StringAttribute{
+				MarkdownDescription: "Only list the aliases of this domain",
+				Optional:            true,
+			},
+			"domain_aliases": schema.ListNestedAttribute{
+				MarkdownDescription: "The domain aliases sorted by domain_alias_name, empty when the customer has none",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain_alias_name": schema.StringAttribute{
+							MarkdownDescription: "The domain alias name",
+							Computed:            true,
+						},
+						"parent_domain_name": schema.StringAttribute{
+							MarkdownDescription: "The domain the alias belongs to",
+							Computed:            true,
+						},
+						"verified": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain alias has been verified",
+							Computed:            true,
+						},
+						"creation_time": schema.StringAttribute{
+							MarkdownDescription: "The time the domain alias was added, in RFC 3339 format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DomainAliasesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.adminService = pd.adminService
+}
+
+func (d *DomainAliasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainAliasesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer := d.customerId
+	if !data.Customer.IsNull() && !data.Customer.IsUnknown() {
+		customer = data.Customer.ValueString()
+	}
+
+	call := d.adminService.DomainAliases.List(customer)
+	if parent := data.ParentDomainName.ValueString(); parent != "" {
+		call = call.ParentDomainName(parent)
+	}
+
+	res, err := call.Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list domain aliases for '%s', got error: %s", customer, formatAPIError(err)),
+		)
+		return
+	}
+
+	// The API omits the list entirely when there are no domain aliases.
+	data.DomainAliases = make([]DomainAliasesDataSourceDomainAlias, 0, len(res.DomainAliases))
+	for _, a := range res.DomainAliases {
+		data.DomainAliases = append(data.DomainAliases, DomainAliasesDataSourceDomainAlias{
+			DomainAliasName:  types.StringValue(a.DomainAliasName),
+			ParentDomainName: types.StringValue(a.ParentDomainName),
+			Verified:         types.BoolValue(a.Verified),
+			CreationTime:     types.StringValue(time.UnixMilli(a.CreationTime).UTC().Format(time.RFC3339)),
+		})
+	}
+
+	data.Customer = types.StringValue(customer)
+	sortByKey(data.DomainAliases, func(a DomainAliasesDataSourceDomainAlias) string { return a.DomainAliasName.ValueString() })
+
+	data.Id = types.StringValue(customer)
+
+	tflog.Trace(ctx, "read domain aliases", map[string]interface{}{
+		"customer": customer,
+		"count":    len(data.DomainAliases),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DomainResource{}
+var _ resource.ResourceWithImportState = &DomainResource{}
+
+func NewDomainResource() resource.Resource {
+	return &DomainResource{}
+}
+
+// DomainResource defines the resource implementation.
+type DomainResource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// DomainResourceModel describes the resource data model.
+type DomainResourceModel struct {
+	DomainName   types.String `tfsdk:"domain_name"`
+	IsPrimary    types.Bool   `tfsdk:"is_primary"`
+	Verified     types.Bool   `tfsdk:"verified"`
+	CreationTime types.String `tfsdk:"creation_time"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (r *DomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Secondary domain resource. Domains cannot be updated, changing the
+		domain name replaces the domain. The primary domain cannot be deleted.` + scopesDescription(admin.AdminDirectoryDomainScope),
+
+		Attributes: map[string]schema.Attribute{
+			"domain_name": schema.StringAttribute{
+				MarkdownDescription: "The domain name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_primary": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is the primary domain of the customer",
+				Computed:            true,
+			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain has been verified",
+				Computed:            true,
+			},
+			"creation_time": schema.StringAttribute{
+				MarkdownDescription: "The time the domain was added, in RFC 3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Domain identifier, same as domain_name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.customerId = pd.customerId
+	r.adminService = pd.adminService
+}
+
+func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Domains.Insert(r.customerId, &admin.Domains{
+		DomainName: data.DomainName.ValueString(),
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Domain",
+			fmt.Sprintf("Could not add domain %s: %v", data.DomainName.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenDomain(res, &data)
+
+	tflog.Trace(ctx, "Created Domain", map[string]interface{}{
+		"domain_name": res.DomainName,
+		"verified":    res.Verified,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Domains.Get(r.customerId, data.DomainName.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Domain no longer exists in Google Workspace, removing from state", map[string]interface{}{
+				"domain_name": data.DomainName.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read domain '%s', got error: %s", data.DomainName.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenDomain(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes, domain_name requires replacement.
+func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.DomainName.ValueString()
+
+	// The domain may have been made primary since it was last read.
+	res, err := r.adminService.Domains.Get(r.customerId, domainName).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "Domain already deleted in Google Workspace", map[string]interface{}{
+				"domain_name": domainName,
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read domain '%s', got error: %s", domainName, formatAPIError(err)),
+		)
+		return
+	}
+
+	if res.IsPrimary {
+		resp.Diagnostics.AddError(
+			"Cannot Delete Primary Domain",
+			fmt.Sprintf("%s is the primary domain of the customer and cannot be deleted. "+
+				"Make another domain primary first, or remove the resource from state with terraform state rm.", domainName),
+		)
+		return
+	}
+
+	err = r.adminService.Domains.Delete(r.customerId, domainName).Context(ctx).Do()
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Domain",
+			fmt.Sprintf("Could not delete domain %s: %v", domainName, formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain_name"), req, resp)
+}
+
+func flattenDomain(d *admin.Domains, data *DomainResourceModel) {
+	data.Id = types.StringValue(d.DomainName)
+	data.DomainName = types.StringValue(d.DomainName)
+	data.IsPrimary = types.BoolValue(d.IsPrimary)
+	data.Verified = types.BoolValue(d.Verified)
+	data.CreationTime = types.StringValue(time.UnixMilli(d.CreationTime).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDomainResourceDelete(t *testing.T) {
+	tests := map[string]struct {
+		getStatus   int
+		getBody     string
+		wantDeleted bool
+		wantErr     bool
+	}{
+		"secondary domain": {
+			getStatus:   http.StatusOK,
+			getBody:     `{"domainName":"example.org","isPrimary":false}`,
+			wantDeleted: true,
+		},
+		"primary domain": {
+			getStatus: http.StatusOK,
+			getBody:   `{"domainName":"example.org","isPrimary":true}`,
+			wantErr:   true,
+		},
+		"already deleted": {
+			getStatus: http.StatusNotFound,
+			getBody:   `{"error":{"code":404,"message":"Domain not found."}}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			srv := newTestAdminService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/admin/directory/v1/customer/C01/domains/example.org" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(test.getStatus)
+					_, _ = w.Write([]byte(test.getBody))
+				case http.MethodDelete:
+					deleted = true
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+
+			d := &DomainResource{adminService: srv, customerId: "C01"}
+
+			req := resource.DeleteRequest{
+				State: newTestResourceState(t, d, DomainResourceModel{
+					DomainName:   types.StringValue("example.org"),
+					IsPrimary:    types.BoolValue(false),
+					Verified:     types.BoolValue(true),
+					CreationTime: types.StringValue("2024-01-01T00:00:00Z"),
+					Id:           types.StringValue("example.org"),
+				}),
+			}
+			resp := &resource.DeleteResponse{}
+			d.Delete(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Errorf("got diagnostics %v, want error %t", resp.Diagnostics, test.wantErr)
+			}
+			if deleted != test.wantDeleted {
+				t.Errorf("got deleted %t, want %t", deleted, test.wantDeleted)
+			}
+		})
+	}
+}
+
+//TODO: Fix tests
+//func TestAccDomainResource(t *testing.T) {
+//	//	resource.Test(t, resource.TestCase{
+//	//		PreCheck:                 func() { testAccPreCheck(t) },
+//	//		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+//	//		Steps: []resource.TestStep{
+//	//			// Create and Read testing
+//	//			{
+//	//				Config: testAccDomainResourceConfig("example.org"),
+//	//				ConfigStateChecks: []statecheck.StateCheck{
+//	//					statecheck.ExpectKnownValue(
+//	//						"googleworkspace_domain.test",
+//	//						tfjsonpath.New("id"),
+//	//						knownvalue.StringExact("example.org"),
+//	//					),
+//	//					statecheck.ExpectKnownValue(
+//	//						"googleworkspace_domain.test",
+//	//						tfjsonpath.New("is_primary"),
+//	//						knownvalue.Bool(false),
+//	//					),
+//	//					// A new domain is not verified until its DNS records are added.
+//	//					statecheck.ExpectKnownValue(
+//	//						"googleworkspace_domain.test",
+//	//						tfjsonpath.New("verified"),
+//	//						knownvalue.Bool(false),
+//	//					),
+//	//				},
+//	//			},
+//	//			// ImportState testing
+//	//			{
+//	//				ResourceName:      "googleworkspace_domain.test",
+//	//				ImportState:       true,
+//	//				ImportStateVerify: true,
+//	//			},
+//	//			// Delete testing automatically occurs in TestCase
+//	//		},
+//	//	})
+//}
+//
+//func testAccDomainResourceConfig(domainName string) string {
+//	return fmt.Sprintf(`
+//resource "googleworkspace_domain" "test" {
+//  domain_name = %[1]q
+//}
+//`, domainName)
+//}
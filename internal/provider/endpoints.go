@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/option"
+)
+
+// serviceEndpoint is an API whose base URL can be overridden, e.g. to run
+// against a mock server.
+type serviceEndpoint struct {
+	// name is the attribute of the endpoints block.
+	name   string
+	envVar string
+	api    string
+}
+
+var serviceEndpoints = []serviceEndpoint{
+	{name: "directory", envVar: "GOOGLEWORKSPACE_DIRECTORY_ENDPOINT", api: "Admin SDK Directory API"},
+	{name: "data_transfer", envVar: "GOOGLEWORKSPACE_DATA_TRANSFER_ENDPOINT", api: "Admin SDK Data Transfer API"},
+	{name: "reports", envVar: "GOOGLEWORKSPACE_REPORTS_ENDPOINT", api: "Admin SDK Reports API"},
+	{name: "groups_settings", envVar: "GOOGLEWORKSPACE_GROUPS_SETTINGS_ENDPOINT", api: "Groups Settings API"},
+	{name: "cloud_identity", envVar: "GOOGLEWORKSPACE_CLOUD_IDENTITY_ENDPOINT", api: "Cloud Identity API, both v1 and v1beta1"},
+	{name: "chrome_policy", envVar: "GOOGLEWORKSPACE_CHROME_POLICY_ENDPOINT", api: "Chrome Policy API"},
+	{name: "licensing", envVar: "GOOGLEWORKSPACE_LICENSING_ENDPOINT", api: "Enterprise License Manager API"},
+	{name: "gmail", envVar: "GOOGLEWORKSPACE_GMAIL_ENDPOINT", api: "Gmail API"},
+}
+
+// endpointsSchema returns the schema of the endpoints provider attribute.
+func endpointsSchema() schema.SingleNestedAttribute {
+	attributes := make(map[string]schema.Attribute, len(serviceEndpoints))
+	for _, e := range serviceEndpoints {
+		attributes[e.name] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Base URL of the %s (defaults to %s)", e.api, e.envVar),
+			Optional:            true,
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: `Base URLs to send API requests to instead of Google's, e.g.
+		'http://localhost:8080/' for a mock server or an isolated Google environment. An
+		attribute takes precedence over its environment variable, APIs without either use
+		Google's default endpoint.`,
+		Attributes: attributes,
+		Optional:   true,
+	}
+}
+
+// resolveEndpoints returns the overridden base URLs by service name, from
+// the endpoints attribute or else the environment.
+func resolveEndpoints(endpoints types.Object, diags *diag.Diagnostics) map[string]string {
+	resolved := map[string]string{}
+
+	for _, e := range serviceEndpoints {
+		endpoint := os.Getenv(e.envVar)
+
+		if !endpoints.IsNull() && !endpoints.IsUnknown() {
+			if v, ok := endpoints.Attributes()[e.name].(types.String); ok {
+				if v.IsUnknown() {
+					diags.AddAttributeError(
+						path.Root("endpoints").AtName(e.name),
+						"Unknown Endpoint",
+						fmt.Sprintf("The endpoint must be known during provider configuration. "+
+							"Either set a static value or use the %s environment variable.", e.envVar),
+					)
+					continue
+				}
+				if !v.IsNull() {
+					endpoint = v.ValueString()
+				}
+			}
+		}
+
+		if endpoint == "" {
+			continue
+		}
+
+		if u, err := url.Parse(endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			diags.AddAttributeError(
+				path.Root("endpoints").AtName(e.name),
+				"Invalid Endpoint",
+				fmt.Sprintf("The endpoint must be a URL such as 'http://localhost:8080/', got: %s", endpoint),
+			)
+			continue
+		}
+
+		resolved[e.name] = endpoint
+	}
+
+	return resolved
+}
+
+// clientOptions returns the options to build the client of service with,
+// sending requests with client to the configured endpoint, if any.
+func (pd *providerData) clientOptions(client *http.Client, service string) []option.ClientOption {
+	opts := []option.ClientOption{option.WithHTTPClient(client)}
+	if endpoint, ok := pd.endpoints[service]; ok {
+		opts = append(opts, option.WithEndpoint(endpoint))
+	}
+
+	return opts
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testEndpoints returns the endpoints attribute with the given values, the
+// other endpoints are null.
+func testEndpoints(t *testing.T, values map[string]types.String) types.Object {
+	t.Helper()
+
+	attrTypes := map[string]attr.Type{}
+	attrValues := map[string]attr.Value{}
+	for _, e := range serviceEndpoints {
+		attrTypes[e.name] = types.StringType
+		attrValues[e.name] = types.StringNull()
+		if v, ok := values[e.name]; ok {
+			attrValues[e.name] = v
+		}
+	}
+
+	obj, diags := types.ObjectValue(attrTypes, attrValues)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	return obj
+}
+
+func TestResolveEndpoints(t *testing.T) {
+	tests := map[string]struct {
+		env       map[string]string
+		endpoints map[string]types.String
+		null      bool
+		want      map[string]string
+		wantErr   bool
+	}{
+		"none": {
+			null: true,
+			want: map[string]string{},
+		},
+		"environment": {
+			env:  map[string]string{"GOOGLEWORKSPACE_DIRECTORY_ENDPOINT": "http://localhost:8080/"},
+			null: true,
+			want: map[string]string{"directory": "http://localhost:8080/"},
+		},
+		"attribute": {
+			endpoints: map[string]types.String{"gmail": types.StringValue("http://localhost:8081/")},
+			want:      map[string]string{"gmail": "http://localhost:8081/"},
+		},
+		"attribute takes precedence over environment": {
+			env:       map[string]string{"GOOGLEWORKSPACE_DIRECTORY_ENDPOINT": "http://localhost:8080/"},
+			endpoints: map[string]types.String{"directory": types.StringValue("http://localhost:9090/")},
+			want:      map[string]string{"directory": "http://localhost:9090/"},
+		},
+		"null attribute falls back to environment": {
+			env:  map[string]string{"GOOGLEWORKSPACE_CHROME_POLICY_ENDPOINT": "http://localhost:8080/"},
+			want: map[string]string{"chrome_policy": "http://localhost:8080/"},
+		},
+		"unknown attribute": {
+			endpoints: map[string]types.String{"directory": types.StringUnknown()},
+			wantErr:   true,
+		},
+		"not a URL": {
+			endpoints: map[string]types.String{"directory": types.StringValue("localhost:8080")},
+			wantErr:   true,
+		},
+		"invalid environment": {
+			env:     map[string]string{"GOOGLEWORKSPACE_REPORTS_ENDPOINT": "://"},
+			null:    true,
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, e := range serviceEndpoints {
+				t.Setenv(e.envVar, test.env[e.envVar])
+			}
+
+			endpoints := testEndpoints(t, test.endpoints)
+			if test.null {
+				endpoints = types.ObjectNull(endpoints.AttributeTypes(context.Background()))
+			}
+
+			var diags diag.Diagnostics
+			got := resolveEndpoints(endpoints, &diags)
+
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			if !maps.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FeatureResource{}
+var _ resource.ResourceWithImportState = &FeatureResource{}
+
+func NewFeatureResource() resource.Resource {
+	return &FeatureResource{}
+}
+
+// FeatureResource defines the resource implementation.
+type FeatureResource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// FeatureResourceModel describes the resource data model.
+type FeatureResourceModel struct {
+	Name  types.String `tfsdk:"name"`
+	Etags types.String `tfsdk:"etags"`
+	Id    types.String `tfsdk:"id"`
+}
+
+func (r *FeatureResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature"
+}
+
+func (r *FeatureResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Feature of calendar resources such as meeting rooms, e.g. "Video" or
+		"Whiteboard". Changing the name renames the feature in place, keeping it assigned to
+		its rooms. A feature still assigned to rooms cannot be deleted.` + scopesDescription(admin.AdminDirectoryResourceCalendarScope),
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the feature",
+				Required:            true,
+			},
+			"etags": schema.StringAttribute{
+				MarkdownDescription: "ETag of the feature",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Feature identifier, same as name",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *FeatureResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.customerId = pd.customerId
+	r.adminService = pd.adminService
+}
+
+func (r *FeatureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FeatureResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Resources.Features.Insert(r.customerId, &admin.Feature{
+		Name: data.Name.ValueString(),
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Feature",
+			fmt.Sprintf("Could not create feature %s: %v", data.Name.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenFeature(res, &data)
+
+	tflog.Trace(ctx, "Created Feature", map[string]interface{}{
+		"name": res.Name,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeatureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FeatureResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Resources.Features.Get(r.customerId, data.Id.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Feature no longer exists in Google Workspace, removing from state", map[string]interface{}{
+				"name": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read feature '%s', got error: %s", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenFeature(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update renames the feature, the name is its only attribute.
+func (r *FeatureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FeatureResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oldName := state.Id.ValueString()
+	newName := data.Name.ValueString()
+
+	err := r.adminService.Resources.Features.Rename(r.customerId, oldName, &admin.FeatureRename{
+		NewName: newName,
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Feature",
+			fmt.Sprintf("Could not rename feature %s to %s: %v", oldName, newName, formatAPIError(err)),
+		)
+		return
+	}
+
+	// Rename does not return the feature.
+	res, err := r.adminService.Resources.Features.Get(r.customerId, newName).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read feature '%s', got error: %s", newName, formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenFeature(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeatureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FeatureResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.adminService.Resources.Features.Delete(r.customerId, data.Id.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "Feature already deleted in Google Workspace", map[string]interface{}{
+				"name": data.Id.ValueString(),
+			})
+			return
+		}
+
+		// Google refuses to delete features still assigned to calendar
+		// resources, its message says so.
+		resp.Diagnostics.AddError(
+			"Error Deleting Feature",
+			fmt.Sprintf("Could not delete feature %s: %v", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *FeatureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func flattenFeature(f *admin.Feature, data *FeatureResourceModel) {
+	data.Id = types.StringValue(f.Name)
+	data.Name = types.StringValue(f.Name)
+	data.Etags = types.StringValue(f.Etags)
+}
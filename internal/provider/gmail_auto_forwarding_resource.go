@@ -0,0 +1,317 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/gmail/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GmailAutoForwardingResource{}
+var _ resource.ResourceWithImportState = &GmailAutoForwardingResource{}
+var _ resource.ResourceWithValidateConfig = &GmailAutoForwardingResource{}
+
+func NewGmailAutoForwardingResource() resource.Resource {
+	return &GmailAutoForwardingResource{}
+}
+
+// GmailAutoForwardingResource defines the resource implementation.
+type GmailAutoForwardingResource struct {
+	providerData *providerData
+}
+
+// GmailAutoForwardingResourceModel describes the resource data model.
+type GmailAutoForwardingResourceModel struct {
+	UserId       types.String `tfsdk:"user_id"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	EmailAddress types.String `tfsdk:"email_address"`
+	Disposition  types.String `tfsdk:"disposition"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (r *GmailAutoForwardingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gmail_auto_forwarding"
+}
+
+func (r *GmailAutoForwardingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Automatic forwarding of all incoming Gmail messages of a user. Every user
+		has this setting, so creating this resource updates it and destroying it disables
+		forwarding. Import the setting of a user by their email address. Requires the
+		gmail.settings.sharing scope to be granted to the service account in the domain-wide
+		delegation settings.`,
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user whose messages are forwarded",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether incoming messages are forwarded. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"email_address": schema.StringAttribute{
+				MarkdownDescription: `Address messages are forwarded to. It must be a verified forwarding
+				address of the user, e.g. a googleworkspace_gmail_forwarding_address whose
+				verification_status is accepted. Required when enabled.`,
+				Optional: true,
+			},
+			"disposition": schema.StringAttribute{
+				MarkdownDescription: `What happens to a message once it has been forwarded, leaveInInbox,
+				archive, trash or markRead. Defaults to leaveInInbox.`,
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("leaveInInbox"),
+				Validators: []validator.String{
+					stringOneOf("leaveInInbox", "archive", "trash", "markRead"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Email address of the user",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GmailAutoForwardingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = pd
+}
+
+// ValidateConfig requires email_address when forwarding is enabled, which
+// Gmail would otherwise only reject on apply.
+func (r *GmailAutoForwardingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GmailAutoForwardingResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Enabled.IsUnknown() || data.EmailAddress.IsUnknown() {
+		return
+	}
+
+	if (data.Enabled.IsNull() || data.Enabled.ValueBool()) && data.EmailAddress.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email_address"),
+			"Missing Forwarding Address",
+			"email_address must be set when auto-forwarding is enabled.",
+		)
+	}
+}
+
+func (r *GmailAutoForwardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GmailAutoForwardingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsSharingScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every user has the setting, so creating it is an update.
+	res, err := srv.Users.Settings.UpdateAutoForwarding(data.UserId.ValueString(), expandGmailAutoForwarding(&data)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Gmail Auto-Forwarding",
+			fmt.Sprintf("Could not enable auto-forwarding of user %s to %s: %v\n\n"+
+				"The forwarding address must be verified before messages can be forwarded to it.",
+				data.UserId.ValueString(), data.EmailAddress.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGmailAutoForwarding(res, &data)
+	data.Id = data.UserId
+
+	tflog.Trace(ctx, "Created Gmail Auto-Forwarding", map[string]interface{}{
+		"user_id": data.UserId.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailAutoForwardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GmailAutoForwardingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsSharingScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := srv.Users.Settings.GetAutoForwarding(data.UserId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "User no longer exists in Google Workspace, removing auto-forwarding from state", map[string]interface{}{
+				"user_id": data.UserId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read auto-forwarding of user '%s', got error: %s", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGmailAutoForwarding(res, &data)
+	data.Id = data.UserId
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailAutoForwardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GmailAutoForwardingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsSharingScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := srv.Users.Settings.UpdateAutoForwarding(data.UserId.ValueString(), expandGmailAutoForwarding(&data)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Gmail Auto-Forwarding",
+			fmt.Sprintf("Could not update auto-forwarding of user %s: %v\n\n"+
+				"The forwarding address must be verified before messages can be forwarded to it.",
+				data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGmailAutoForwarding(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailAutoForwardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GmailAutoForwardingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsSharingScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The setting can't be deleted, disable forwarding instead.
+	_, err := srv.Users.Settings.UpdateAutoForwarding(data.UserId.ValueString(), &gmail.AutoForwarding{
+		Enabled:         false,
+		ForceSendFields: []string{"Enabled"},
+	}).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// The user itself is gone, and its settings with it.
+			tflog.Warn(ctx, "User already deleted in Google Workspace", map[string]interface{}{
+				"user_id": data.UserId.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Gmail Auto-Forwarding",
+			fmt.Sprintf("Could not disable auto-forwarding of user %s: %v", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *GmailAutoForwardingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Every user has the setting, Read fills in the current one.
+	resource.ImportStatePassthroughID(ctx, path.Root("user_id"), req, resp)
+}
+
+func expandGmailAutoForwarding(data *GmailAutoForwardingResourceModel) *gmail.AutoForwarding {
+	return &gmail.AutoForwarding{
+		Enabled:         data.Enabled.ValueBool(),
+		EmailAddress:    data.EmailAddress.ValueString(),
+		Disposition:     data.Disposition.ValueString(),
+		ForceSendFields: []string{"Enabled"},
+	}
+}
+
+// flattenGmailAutoForwarding stores f in data. While forwarding is disabled,
+// Gmail may still return the last address or leave out the disposition, so
+// an unset address stays unset and a missing disposition keeps its prior
+// value.
+func flattenGmailAutoForwarding(f *gmail.AutoForwarding, data *GmailAutoForwardingResourceModel) {
+	data.Enabled = types.BoolValue(f.Enabled)
+	if f.Enabled || !data.EmailAddress.IsNull() {
+		data.EmailAddress = stringOrNull(f.EmailAddress)
+	}
+	if f.Disposition != "" && f.Disposition != "dispositionUnspecified" {
+		data.Disposition = types.StringValue(f.Disposition)
+	} else if data.Disposition.IsNull() {
+		data.Disposition = types.StringValue("leaveInInbox")
+	}
+}
@@ -0,0 +1,402 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/gmail/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GmailFilterResource{}
+var _ resource.ResourceWithImportState = &GmailFilterResource{}
+
+func NewGmailFilterResource() resource.Resource {
+	return &GmailFilterResource{}
+}
+
+// GmailFilterResource defines the resource implementation. Gmail settings
+// can only be managed by the user they belong to, so every request acts as
+// user_id rather than the impersonated user.
+type GmailFilterResource struct {
+	providerData *providerData
+}
+
+// GmailFilterResourceModel describes the resource data model.
+type GmailFilterResourceModel struct {
+	UserId   types.String              `tfsdk:"user_id"`
+	Criteria *GmailFilterCriteriaModel `tfsdk:"criteria"`
+	Action   *GmailFilterActionModel   `tfsdk:"action"`
+	FilterId types.String              `tfsdk:"filter_id"`
+	Id       types.String              `tfsdk:"id"`
+}
+
+// Nested Model for "criteria".
+type GmailFilterCriteriaModel struct {
+	From          types.String `tfsdk:"from"`
+	To            types.String `tfsdk:"to"`
+	Subject       types.String `tfsdk:"subject"`
+	Query         types.String `tfsdk:"query"`
+	HasAttachment types.Bool   `tfsdk:"has_attachment"`
+}
+
+// Nested Model for "action".
+type GmailFilterActionModel struct {
+	AddLabelIds    types.List   `tfsdk:"add_label_ids"`
+	RemoveLabelIds types.List   `tfsdk:"remove_label_ids"`
+	Forward        types.String `tfsdk:"forward"`
+}
+
+func (r *GmailFilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gmail_filter"
+}
+
+func (r *GmailFilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Gmail filter of a user. Filters cannot be modified, so any change
+		replaces the filter. Requires the gmail.settings.basic scope to be granted to the
+		service account in the domain-wide delegation settings.`,
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user the filter belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"criteria": schema.SingleNestedAttribute{
+				MarkdownDescription: "The messages the filter applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"from": schema.StringAttribute{
+						MarkdownDescription: "The sender's display name or email address",
+						Optional:            true,
+					},
+					"to": schema.StringAttribute{
+						MarkdownDescription: "The recipient's display name or email address, including cc and bcc",
+						Optional:            true,
+					},
+					"subject": schema.StringAttribute{
+						MarkdownDescription: "Case-insensitive phrase found in the message's subject",
+						Optional:            true,
+					},
+					"query": schema.StringAttribute{
+						MarkdownDescription: "Only return messages matching this Gmail search query, e.g. 'from:someuser@example.com is:unread'",
+						Optional:            true,
+					},
+					"has_attachment": schema.BoolAttribute{
+						MarkdownDescription: "Whether the message has any attachment",
+						Optional:            true,
+					},
+				},
+			},
+			"action": schema.SingleNestedAttribute{
+				MarkdownDescription: "The action performed on matching messages",
+				Required:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"add_label_ids": schema.ListAttribute{
+						MarkdownDescription: "IDs of the labels to add to the message",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"remove_label_ids": schema.ListAttribute{
+						MarkdownDescription: "IDs of the labels to remove from the message",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"forward": schema.StringAttribute{
+						MarkdownDescription: "Email address the message is forwarded to, it must be a verified forwarding address of the user",
+						Optional:            true,
+					},
+				},
+			},
+			"filter_id": schema.StringAttribute{
+				MarkdownDescription: "The server assigned ID of the filter",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Filter identifier in the format user_id/filter_id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GmailFilterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = pd
+}
+
+func (r *GmailFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GmailFilterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := expandGmailFilter(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := srv.Users.Settings.Filters.Create(data.UserId.ValueString(), filter).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Gmail Filter",
+			fmt.Sprintf("Could not create filter for user %s: %v", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenGmailFilter(ctx, res, &data)...)
+
+	tflog.Trace(ctx, "Created Gmail Filter", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GmailFilterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := srv.Users.Settings.Filters.Get(data.UserId.ValueString(), data.FilterId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Gmail filter no longer exists, removing from state", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Gmail filter '%s', got error: %s", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenGmailFilter(ctx, res, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes since every attribute requires
+// replacement.
+func (r *GmailFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GmailFilterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GmailFilterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := srv.Users.Settings.Filters.Delete(data.UserId.ValueString(), data.FilterId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "Gmail filter already deleted", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Gmail Filter",
+			fmt.Sprintf("Could not delete Gmail filter %s: %v", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *GmailFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userId, filterId, ok := strings.Cut(req.ID, "/")
+	if !ok || userId == "" || filterId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier in the format user_id/filter_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("filter_id"), filterId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func expandGmailFilter(ctx context.Context, data *GmailFilterResourceModel) (*gmail.Filter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := &gmail.Filter{
+		Criteria: &gmail.FilterCriteria{},
+		Action:   &gmail.FilterAction{},
+	}
+
+	if data.Criteria != nil {
+		filter.Criteria.From = data.Criteria.From.ValueString()
+		filter.Criteria.To = data.Criteria.To.ValueString()
+		filter.Criteria.Subject = data.Criteria.Subject.ValueString()
+		filter.Criteria.Query = data.Criteria.Query.ValueString()
+		filter.Criteria.HasAttachment = data.Criteria.HasAttachment.ValueBool()
+	}
+
+	if data.Action != nil {
+		diags.Append(data.Action.AddLabelIds.ElementsAs(ctx, &filter.Action.AddLabelIds, false)...)
+		diags.Append(data.Action.RemoveLabelIds.ElementsAs(ctx, &filter.Action.RemoveLabelIds, false)...)
+		filter.Action.Forward = data.Action.Forward.ValueString()
+	}
+
+	return filter, diags
+}
+
+// flattenGmailFilter stores filter in data. Gmail omits unset criteria and
+// actions, which are kept null unless they were set before.
+func flattenGmailFilter(ctx context.Context, filter *gmail.Filter, data *GmailFilterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.FilterId = types.StringValue(filter.Id)
+	data.Id = types.StringValue(data.UserId.ValueString() + "/" + filter.Id)
+
+	criteria := filter.Criteria
+	if criteria == nil {
+		criteria = &gmail.FilterCriteria{}
+	}
+	prior := data.Criteria
+	if prior == nil {
+		prior = &GmailFilterCriteriaModel{}
+	}
+	data.Criteria = &GmailFilterCriteriaModel{
+		From:          gmailFilterString(criteria.From, prior.From),
+		To:            gmailFilterString(criteria.To, prior.To),
+		Subject:       gmailFilterString(criteria.Subject, prior.Subject),
+		Query:         gmailFilterString(criteria.Query, prior.Query),
+		HasAttachment: prior.HasAttachment,
+	}
+	if criteria.HasAttachment || !prior.HasAttachment.IsNull() {
+		data.Criteria.HasAttachment = types.BoolValue(criteria.HasAttachment)
+	}
+
+	action := filter.Action
+	if action == nil {
+		action = &gmail.FilterAction{}
+	}
+	priorAction := data.Action
+	if priorAction == nil {
+		priorAction = &GmailFilterActionModel{
+			AddLabelIds:    types.ListNull(types.StringType),
+			RemoveLabelIds: types.ListNull(types.StringType),
+		}
+	}
+	data.Action = &GmailFilterActionModel{
+		AddLabelIds:    priorAction.AddLabelIds,
+		RemoveLabelIds: priorAction.RemoveLabelIds,
+		Forward:        gmailFilterString(action.Forward, priorAction.Forward),
+	}
+	if len(action.AddLabelIds) > 0 || !priorAction.AddLabelIds.IsNull() {
+		addLabelIds, d := types.ListValueFrom(ctx, types.StringType, action.AddLabelIds)
+		diags.Append(d...)
+		data.Action.AddLabelIds = addLabelIds
+	}
+	if len(action.RemoveLabelIds) > 0 || !priorAction.RemoveLabelIds.IsNull() {
+		removeLabelIds, d := types.ListValueFrom(ctx, types.StringType, action.RemoveLabelIds)
+		diags.Append(d...)
+		data.Action.RemoveLabelIds = removeLabelIds
+	}
+
+	return diags
+}
+
+// gmailFilterString keeps an unset value null rather than an empty string.
+func gmailFilterString(value string, prior types.String) types.String {
+	if value == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
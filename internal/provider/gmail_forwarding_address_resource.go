@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/gmail/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GmailForwardingAddressResource{}
+var _ resource.ResourceWithImportState = &GmailForwardingAddressResource{}
+
+func NewGmailForwardingAddressResource() resource.Resource {
+	return &GmailForwardingAddressResource{}
+}
+
+// GmailForwardingAddressResource defines the resource implementation. Like
+// filters, forwarding addresses are managed as the user they belong to.
+type GmailForwardingAddressResource struct {
+	providerData *providerData
+}
+
+// GmailForwardingAddressResourceModel describes the resource data model.
+type GmailForwardingAddressResourceModel struct {
+	UserId             types.String `tfsdk:"user_id"`
+	ForwardingEmail    types.String `tfsdk:"forwarding_email"`
+	VerificationStatus types.String `tfsdk:"verification_status"`
+	Id                 types.String `tfsdk:"id"`
+}
+
+func (r *GmailForwardingAddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gmail_forwarding_address"
+}
+
+func (r *GmailForwardingAddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Address a user can forward their Gmail messages to. Addresses outside of
+		the domain have to be verified by their owner before they can be used. Forwarding
+		addresses cannot be modified, so any change replaces the address. Requires the
+		gmail.settings.sharing scope to be granted to the service account in the domain-wide
+		delegation settings.`,
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user the forwarding address belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"forwarding_email": schema.StringAttribute{
+				MarkdownDescription: "The email address messages can be forwarded to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"verification_status": schema.StringAttribute{
+				MarkdownDescription: "Whether the address can be used for forwarding, accepted or pending",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Forwarding address identifier in the format user_id/forwarding_email",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GmailForwardingAddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = pd
+}
+
+func (r *GmailForwardingAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GmailForwardingAddressResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsSharingScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := srv.Users.Settings.ForwardingAddresses.Create(data.UserId.ValueString(), &gmail.ForwardingAddress{
+		ForwardingEmail: data.ForwardingEmail.ValueString(),
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Gmail Forwarding Address",
+			fmt.Sprintf("Could not create forwarding address %s for user %s: %v", data.ForwardingEmail.ValueString(), data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGmailForwardingAddress(res, &data)
+
+	tflog.Trace(ctx, "Created Gmail Forwarding Address", map[string]interface{}{
+		"id":                  data.Id.ValueString(),
+		"verification_status": data.VerificationStatus.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailForwardingAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GmailForwardingAddressResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsSharingScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := srv.Users.Settings.ForwardingAddresses.Get(data.UserId.ValueString(), data.ForwardingEmail.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Gmail forwarding address no longer exists, removing from state", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Gmail forwarding address '%s', got error: %s", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGmailForwardingAddress(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes since every attribute requires
+// replacement.
+func (r *GmailForwardingAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GmailForwardingAddressResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailForwardingAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GmailForwardingAddressResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsSharingScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := srv.Users.Settings.ForwardingAddresses.Delete(data.UserId.ValueString(), data.ForwardingEmail.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "Gmail forwarding address already deleted", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Gmail Forwarding Address",
+			fmt.Sprintf("Could not delete Gmail forwarding address %s: %v", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *GmailForwardingAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userId, forwardingEmail, ok := strings.Cut(req.ID, "/")
+	if !ok || userId == "" || forwardingEmail == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier in the format user_id/forwarding_email, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("forwarding_email"), forwardingEmail)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// flattenGmailForwardingAddress stores address in data. The configured
+// forwarding_email is kept when Gmail returns it in a different case.
+func flattenGmailForwardingAddress(address *gmail.ForwardingAddress, data *GmailForwardingAddressResourceModel) {
+	if canonicalKey(address.ForwardingEmail) != canonicalKey(data.ForwardingEmail.ValueString()) {
+		data.ForwardingEmail = types.StringValue(address.ForwardingEmail)
+	}
+	data.VerificationStatus = stringOrNull(address.VerificationStatus)
+	data.Id = types.StringValue(data.UserId.ValueString() + "/" + data.ForwardingEmail.ValueString())
+}
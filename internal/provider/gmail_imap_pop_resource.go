@@ -0,0 +1,380 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/gmail/v1"
+)
+
+// The IMAP and POP settings Google applies to a new mailbox. They are used
+// as the schema defaults and restored on Delete.
+const (
+	defaultGmailImapEnabled     = true
+	defaultGmailPopAccessWindow = "fromNowOn"
+	defaultGmailPopDisposition  = "leaveInInbox"
+)
+
+// gmailPopAccessWindowDisabled is the access window that turns POP off.
+const gmailPopAccessWindowDisabled = "disabled"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GmailImapPopResource{}
+var _ resource.ResourceWithImportState = &GmailImapPopResource{}
+
+func NewGmailImapPopResource() resource.Resource {
+	return &GmailImapPopResource{}
+}
+
+// GmailImapPopResource defines the resource implementation.
+type GmailImapPopResource struct {
+	providerData *providerData
+}
+
+// GmailImapPopResourceModel describes the resource data model.
+type GmailImapPopResourceModel struct {
+	UserId          types.String `tfsdk:"user_id"`
+	ImapEnabled     types.Bool   `tfsdk:"imap_enabled"`
+	PopEnabled      types.Bool   `tfsdk:"pop_enabled"`
+	PopAccessWindow types.String `tfsdk:"pop_access_window"`
+	PopDisposition  types.String `tfsdk:"pop_disposition"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *GmailImapPopResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gmail_imap_pop"
+}
+
+func (r *GmailImapPopResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `IMAP and POP access to the Gmail mailbox of a user. Every user has these
+		settings, so creating this resource updates them and destroying it restores Google's
+		defaults, IMAP enabled and POP disabled. Other IMAP settings, such as the expunge
+		behavior, are left as they are. Import the settings of a user by their email address.
+		Requires the gmail.settings.basic scope to be granted to the service account in the
+		domain-wide delegation settings.`,
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user the settings belong to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"imap_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the mailbox can be accessed over IMAP. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(defaultGmailImapEnabled),
+			},
+			"pop_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the mailbox can be accessed over POP. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"pop_access_window": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(`The messages that can be fetched over POP when enabled, allMail or
+				fromNowOn. Defaults to %s.`, defaultGmailPopAccessWindow),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultGmailPopAccessWindow),
+				Validators: []validator.String{
+					stringOneOf("allMail", "fromNowOn"),
+				},
+			},
+			"pop_disposition": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(`What happens to a message once it has been fetched over POP,
+				leaveInInbox, archive, trash or markRead. Defaults to %s.`, defaultGmailPopDisposition),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultGmailPopDisposition),
+				Validators: []validator.String{
+					stringOneOf("leaveInInbox", "archive", "trash", "markRead"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Email address of the user",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GmailImapPopResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = pd
+}
+
+func (r *GmailImapPopResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GmailImapPopResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every user has the settings, so creating them is an update.
+	resp.Diagnostics.Append(updateGmailImapPop(ctx, srv, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = data.UserId
+
+	tflog.Trace(ctx, "Created Gmail IMAP and POP Settings", map[string]interface{}{
+		"user_id": data.UserId.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailImapPopResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GmailImapPopResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imap, err := srv.Users.Settings.GetImap(data.UserId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "User no longer exists in Google Workspace, removing IMAP and POP settings from state", map[string]interface{}{
+				"user_id": data.UserId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read IMAP settings of user '%s', got error: %s", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	pop, err := srv.Users.Settings.GetPop(data.UserId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read POP settings of user '%s', got error: %s", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGmailImapPop(imap, pop, &data)
+	data.Id = data.UserId
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailImapPopResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GmailImapPopResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateGmailImapPop(ctx, srv, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailImapPopResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GmailImapPopResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted, restore the defaults instead.
+	imap, err := srv.Users.Settings.GetImap(data.UserId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// The user itself is gone, and its settings with it.
+			tflog.Warn(ctx, "User already deleted in Google Workspace", map[string]interface{}{
+				"user_id": data.UserId.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Gmail IMAP and POP Settings",
+			fmt.Sprintf("Could not read IMAP settings of user %s: %v", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	imap.Enabled = defaultGmailImapEnabled
+	imap.ForceSendFields = []string{"Enabled"}
+	_, err = srv.Users.Settings.UpdateImap(data.UserId.ValueString(), imap).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Gmail IMAP and POP Settings",
+			fmt.Sprintf("Could not restore default IMAP settings of user %s: %v", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	_, err = srv.Users.Settings.UpdatePop(data.UserId.ValueString(), &gmail.PopSettings{
+		AccessWindow: gmailPopAccessWindowDisabled,
+		Disposition:  defaultGmailPopDisposition,
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Gmail IMAP and POP Settings",
+			fmt.Sprintf("Could not restore default POP settings of user %s: %v", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *GmailImapPopResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Every user has the settings, Read fills in the current ones.
+	resource.ImportStatePassthroughID(ctx, path.Root("user_id"), req, resp)
+}
+
+// updateGmailImapPop applies the settings in data and stores the result in
+// it. IMAP is read first so the IMAP settings this resource doesn't manage
+// are sent back unchanged.
+func updateGmailImapPop(ctx context.Context, srv *gmail.Service, data *GmailImapPopResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	userId := data.UserId.ValueString()
+
+	imap, err := srv.Users.Settings.GetImap(userId).Context(ctx).Do()
+	if err != nil {
+		diags.AddError(
+			"Error Updating Gmail IMAP Settings",
+			fmt.Sprintf("Could not read IMAP settings of user %s: %v", userId, formatAPIError(err)),
+		)
+		return diags
+	}
+
+	imap.Enabled = data.ImapEnabled.ValueBool()
+	imap.ForceSendFields = []string{"Enabled"}
+	imap, err = srv.Users.Settings.UpdateImap(userId, imap).Context(ctx).Do()
+	if err != nil {
+		diags.AddError(
+			"Error Updating Gmail IMAP Settings",
+			fmt.Sprintf("Could not update IMAP settings of user %s: %v", userId, formatAPIError(err)),
+		)
+		return diags
+	}
+
+	pop, err := srv.Users.Settings.UpdatePop(userId, expandGmailPop(data)).Context(ctx).Do()
+	if err != nil {
+		diags.AddError(
+			"Error Updating Gmail POP Settings",
+			fmt.Sprintf("Could not update POP settings of user %s: %v", userId, formatAPIError(err)),
+		)
+		return diags
+	}
+
+	flattenGmailImapPop(imap, pop, data)
+
+	return diags
+}
+
+// expandGmailPop converts data into POP settings. Gmail has no separate
+// switch for POP, it is disabled through the access window.
+func expandGmailPop(data *GmailImapPopResourceModel) *gmail.PopSettings {
+	pop := &gmail.PopSettings{
+		AccessWindow: data.PopAccessWindow.ValueString(),
+		Disposition:  data.PopDisposition.ValueString(),
+	}
+	if !data.PopEnabled.ValueBool() {
+		pop.AccessWindow = gmailPopAccessWindowDisabled
+	}
+
+	return pop
+}
+
+// flattenGmailImapPop stores the settings in data. While POP is disabled
+// Gmail doesn't report an access window, so the prior one is kept.
+func flattenGmailImapPop(imap *gmail.ImapSettings, pop *gmail.PopSettings, data *GmailImapPopResourceModel) {
+	data.ImapEnabled = types.BoolValue(imap.Enabled)
+
+	enabled := pop.AccessWindow != "" && pop.AccessWindow != "accessWindowUnspecified" && pop.AccessWindow != gmailPopAccessWindowDisabled
+	data.PopEnabled = types.BoolValue(enabled)
+	if enabled {
+		data.PopAccessWindow = types.StringValue(pop.AccessWindow)
+	} else if data.PopAccessWindow.IsNull() {
+		data.PopAccessWindow = types.StringValue(defaultGmailPopAccessWindow)
+	}
+
+	if pop.Disposition != "" && pop.Disposition != "dispositionUnspecified" {
+		data.PopDisposition = types.StringValue(pop.Disposition)
+	} else if data.PopDisposition.IsNull() {
+		data.PopDisposition = types.StringValue(defaultGmailPopDisposition)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/gmail/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GmailSendAsDataSource{}
+
+func NewGmailSendAsDataSource() datasource.DataSource {
+	return &GmailSendAsDataSource{}
+}
+
+// GmailSendAsDataSource defines the data source implementation.
+type GmailSendAsDataSource struct {
+	providerData *providerData
+}
+
+// GmailSendAsDataSourceModel describes the data source data model.
+type GmailSendAsDataSourceModel struct {
+	UserId             types.String `tfsdk:"user_id"`
+	SendAsEmail        types.String `tfsdk:"send_as_email"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	ReplyToAddress     types.String `tfsdk:"reply_to_address"`
+	IsPrimary          types.Bool   `tfsdk:"is_primary"`
+	IsDefault          types.Bool   `tfsdk:"is_default"`
+	TreatAsAlias       types.Bool   `tfsdk:"treat_as_alias"`
+	VerificationStatus types.String `tfsdk:"verification_status"`
+	Id                 types.String `tfsdk:"id"`
+}
+
+func (d *GmailSendAsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gmail_send_as"
+}
+
+func (d *GmailSendAsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Send-as alias of a user's Gmail account, e.g. to check whether it has been
+		verified after running the googleworkspace_verify_send_as action`,
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Primary email address of the user the alias belongs to",
+				Required:            true,
+			},
+			"send_as_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the send-as alias",
+				Required:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The name that appears in the From: header of mail sent as the alias",
+				Computed:            true,
+			},
+			"reply_to_address": schema.StringAttribute{
+				MarkdownDescription: "The address replies are sent to, empty when not set",
+				Computed:            true,
+			},
+			"is_primary": schema.BoolAttribute{
+				MarkdownDescription: "Whether the alias is the user's primary address",
+				Computed:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the alias is selected as From: address by default",
+				Computed:            true,
+			},
+			"treat_as_alias": schema.BoolAttribute{
+				MarkdownDescription: "Whether Gmail treats the address as an alias of the user's address",
+				Computed:            true,
+			},
+			"verification_status": schema.StringAttribute{
+				MarkdownDescription: `Whether the alias can be used, 'accepted' or 'pending'. Empty for
+				aliases of the user's own addresses, which need no verification.`,
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier, in the format user_id/send_as_email",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GmailSendAsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = pd
+}
+
+func (d *GmailSendAsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GmailSendAsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userId := data.UserId.ValueString()
+	sendAsEmail := data.SendAsEmail.ValueString()
+
+	srv := d.providerData.gmailService(ctx, userId, gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sendAs, err := srv.Users.Settings.SendAs.Get(userId, sendAsEmail).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read send-as alias '%s' of user '%s', got error: %s", sendAsEmail, userId, formatAPIError(err)),
+		)
+		return
+	}
+
+	data.SendAsEmail = types.StringValue(sendAs.SendAsEmail)
+	data.DisplayName = types.StringValue(sendAs.DisplayName)
+	data.ReplyToAddress = types.StringValue(sendAs.ReplyToAddress)
+	data.IsPrimary = types.BoolValue(sendAs.IsPrimary)
+	data.IsDefault = types.BoolValue(sendAs.IsDefault)
+	data.TreatAsAlias = types.BoolValue(sendAs.TreatAsAlias)
+	data.VerificationStatus = types.StringValue(sendAs.VerificationStatus)
+	data.Id = types.StringValue(userId + "/" + sendAs.SendAsEmail)
+
+	tflog.Trace(ctx, "read send-as alias", map[string]interface{}{
+		"user_id":             userId,
+		"send_as_email":       sendAs.SendAsEmail,
+		"verification_status": sendAs.VerificationStatus,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,367 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/gmail/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GmailVacationResource{}
+var _ resource.ResourceWithImportState = &GmailVacationResource{}
+var _ resource.ResourceWithValidateConfig = &GmailVacationResource{}
+
+func NewGmailVacationResource() resource.Resource {
+	return &GmailVacationResource{}
+}
+
+// GmailVacationResource defines the resource implementation.
+type GmailVacationResource struct {
+	providerData *providerData
+}
+
+// GmailVacationResourceModel describes the resource data model.
+type GmailVacationResourceModel struct {
+	UserId             types.String `tfsdk:"user_id"`
+	EnableAutoReply    types.Bool   `tfsdk:"enable_auto_reply"`
+	ResponseSubject    types.String `tfsdk:"response_subject"`
+	ResponseBodyHtml   types.String `tfsdk:"response_body_html"`
+	RestrictToContacts types.Bool   `tfsdk:"restrict_to_contacts"`
+	RestrictToDomain   types.Bool   `tfsdk:"restrict_to_domain"`
+	StartTime          types.String `tfsdk:"start_time"`
+	EndTime            types.String `tfsdk:"end_time"`
+	Id                 types.String `tfsdk:"id"`
+}
+
+func (r *GmailVacationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gmail_vacation"
+}
+
+func (r *GmailVacationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Vacation responder of a user, which automatically replies to incoming
+		Gmail messages. Every user has this setting, so creating this resource updates it and
+		destroying it disables the auto-reply. Import the setting of a user by their email
+		address. Requires the gmail.settings.basic scope to be granted to the service account
+		in the domain-wide delegation settings.`,
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user the responder belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enable_auto_reply": schema.BoolAttribute{
+				MarkdownDescription: "Whether incoming messages are answered. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"response_subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the reply, Gmail uses the subject of the incoming message when unset",
+				Optional:            true,
+			},
+			"response_body_html": schema.StringAttribute{
+				MarkdownDescription: "Body of the reply in HTML",
+				Optional:            true,
+			},
+			"restrict_to_contacts": schema.BoolAttribute{
+				MarkdownDescription: "Whether only senders in the user's contacts get a reply. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"restrict_to_domain": schema.BoolAttribute{
+				MarkdownDescription: "Whether only senders in the user's domain get a reply. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 time the responder starts replying, e.g. '2025-07-01T00:00:00Z'. Replies right away when unset.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringIsTime(time.RFC3339),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 time the responder stops replying. Keeps replying when unset.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringIsTime(time.RFC3339),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Email address of the user",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GmailVacationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = pd
+}
+
+// ValidateConfig checks that the responder ends after it starts.
+func (r *GmailVacationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GmailVacationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.StartTime.IsNull() || data.StartTime.IsUnknown() || data.EndTime.IsNull() || data.EndTime.IsUnknown() {
+		return
+	}
+
+	// Malformed times are reported by the attribute validators.
+	start, err := time.Parse(time.RFC3339, data.StartTime.ValueString())
+	if err != nil {
+		return
+	}
+	end, err := time.Parse(time.RFC3339, data.EndTime.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !start.Before(end) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Invalid Vacation Period",
+			fmt.Sprintf("end_time must be after start_time, got start_time %s and end_time %s.",
+				data.StartTime.ValueString(), data.EndTime.ValueString()),
+		)
+	}
+}
+
+func (r *GmailVacationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GmailVacationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every user has the setting, so creating it is an update.
+	res, err := srv.Users.Settings.UpdateVacation(data.UserId.ValueString(), expandGmailVacation(&data)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Gmail Vacation Responder",
+			fmt.Sprintf("Could not update vacation responder of user %s: %v", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGmailVacation(res, &data)
+	data.Id = data.UserId
+
+	tflog.Trace(ctx, "Created Gmail Vacation Responder", map[string]interface{}{
+		"user_id": data.UserId.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailVacationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GmailVacationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := srv.Users.Settings.GetVacation(data.UserId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "User no longer exists in Google Workspace, removing vacation responder from state", map[string]interface{}{
+				"user_id": data.UserId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read vacation responder of user '%s', got error: %s", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGmailVacation(res, &data)
+	data.Id = data.UserId
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailVacationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GmailVacationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := srv.Users.Settings.UpdateVacation(data.UserId.ValueString(), expandGmailVacation(&data)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Gmail Vacation Responder",
+			fmt.Sprintf("Could not update vacation responder of user %s: %v", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGmailVacation(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GmailVacationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GmailVacationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	srv := r.providerData.gmailService(ctx, data.UserId.ValueString(), gmail.GmailSettingsBasicScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The setting can't be deleted, disable the auto-reply instead.
+	_, err := srv.Users.Settings.UpdateVacation(data.UserId.ValueString(), &gmail.VacationSettings{
+		EnableAutoReply: false,
+		ForceSendFields: []string{"EnableAutoReply"},
+	}).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// The user itself is gone, and its settings with it.
+			tflog.Warn(ctx, "User already deleted in Google Workspace", map[string]interface{}{
+				"user_id": data.UserId.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Gmail Vacation Responder",
+			fmt.Sprintf("Could not disable vacation responder of user %s: %v", data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *GmailVacationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Every user has the setting, Read fills in the current one.
+	resource.ImportStatePassthroughID(ctx, path.Root("user_id"), req, resp)
+}
+
+// expandGmailVacation converts data into the settings to send. Times were
+// validated by the schema, so parse errors are ignored.
+func expandGmailVacation(data *GmailVacationResourceModel) *gmail.VacationSettings {
+	v := &gmail.VacationSettings{
+		EnableAutoReply:    data.EnableAutoReply.ValueBool(),
+		ResponseSubject:    data.ResponseSubject.ValueString(),
+		ResponseBodyHtml:   data.ResponseBodyHtml.ValueString(),
+		RestrictToContacts: data.RestrictToContacts.ValueBool(),
+		RestrictToDomain:   data.RestrictToDomain.ValueBool(),
+		ForceSendFields:    []string{"EnableAutoReply", "RestrictToContacts", "RestrictToDomain"},
+	}
+	if t, err := time.Parse(time.RFC3339, data.StartTime.ValueString()); err == nil {
+		v.StartTime = t.UnixMilli()
+	}
+	if t, err := time.Parse(time.RFC3339, data.EndTime.ValueString()); err == nil {
+		v.EndTime = t.UnixMilli()
+	}
+
+	return v
+}
+
+func flattenGmailVacation(v *gmail.VacationSettings, data *GmailVacationResourceModel) {
+	data.EnableAutoReply = types.BoolValue(v.EnableAutoReply)
+	data.ResponseSubject = stringOrNull(v.ResponseSubject)
+	data.ResponseBodyHtml = stringOrNull(v.ResponseBodyHtml)
+	data.RestrictToContacts = types.BoolValue(v.RestrictToContacts)
+	data.RestrictToDomain = types.BoolValue(v.RestrictToDomain)
+	data.StartTime = gmailVacationTime(v.StartTime, data.StartTime)
+	data.EndTime = gmailVacationTime(v.EndTime, data.EndTime)
+}
+
+// gmailVacationTime formats a time in milliseconds since the epoch as RFC
+// 3339. The prior value is kept when it is the same instant written in
+// another time zone.
+func gmailVacationTime(ms int64, prior types.String) types.String {
+	if ms == 0 {
+		return types.StringNull()
+	}
+
+	t := time.UnixMilli(ms)
+	if p, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && p.Equal(t) {
+		return prior
+	}
+
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
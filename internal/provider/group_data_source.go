@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -14,11 +13,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	admin "google.golang.org/api/admin/directory/v1"
-	"google.golang.org/api/option"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &GroupDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &GroupDataSource{}
 
 func NewGroupDataSource() datasource.DataSource {
 	return &GroupDataSource{}
@@ -33,10 +32,12 @@ type GroupDataSource struct {
 
 // GroupDataSourceModel describes the data source data model.
 type GroupDataSourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	Email       types.String `tfsdk:"email"`
-	Description types.String `tfsdk:"description"`
-	Id          types.String `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Email              types.String   `tfsdk:"email"`
+	Description        types.String   `tfsdk:"description"`
+	Aliases            []types.String `tfsdk:"aliases"`
+	NonEditableAliases []types.String `tfsdk:"non_editable_aliases"`
+	Id                 types.String   `tfsdk:"id"`
 }
 
 func (d *GroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -50,11 +51,14 @@ func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Group configurable attribute",
-				Required:            true,
+				MarkdownDescription: `Group key to look up when neither ` + "`id`" + ` nor ` + "`email`" + ` is set.
+				Despite its name this must be the group email, alias or id, display names are not
+				valid keys.`,
+				Optional: true,
+				Computed: true,
 			},
 			"email": schema.StringAttribute{
-				MarkdownDescription: "Group configurable attribute",
+				MarkdownDescription: "Email address of the group to look up when `id` is not set",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -62,39 +66,61 @@ func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "Group configurable attribute",
 				Computed:            true,
 			},
+			"aliases": schema.ListAttribute{
+				MarkdownDescription: "Additional email addresses of the group that can be managed, sorted by address",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"non_editable_aliases": schema.ListAttribute{
+				MarkdownDescription: "Aliases of the group derived from the customer's domain aliases, sorted by address",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Group identifier",
+				MarkdownDescription: "Unique ID of the group to look up. Takes precedence over `email` and `name`.",
+				Optional:            true,
 				Computed:            true,
 			},
 		},
 	}
 }
 
+func (d *GroupDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data GroupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() && data.Email.IsNull() && data.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Group Key",
+			"One of id, email or name must be set to look up a group.",
+		)
+	}
+}
+
 func (d *GroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*http.Client)
+	pd, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
-	srv, err := admin.NewService(ctx, option.WithHTTPClient(client))
-	if err != nil {
-		log.Fatalf("Unable to retrieve directory Client %v", err)
-	}
-
-	d.adminService = srv
-
+	d.client = pd.client
+	d.adminService = pd.adminService
 }
 
 func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -107,11 +133,29 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	g, err := d.adminService.Groups.Get(data.Name.ValueString()).Context(ctx).Do()
+	// Groups.Get only accepts the email, an alias or the id of a group. Prefer
+	// the id, which survives renames, then the email, and keep accepting a key
+	// in name for existing configurations.
+	groupKey := data.Id.ValueString()
+	if groupKey == "" {
+		groupKey = data.Email.ValueString()
+	}
+	if groupKey == "" {
+		groupKey = data.Name.ValueString()
+	}
+	if groupKey == "" {
+		resp.Diagnostics.AddError(
+			"Missing Group Key",
+			"One of id, email or name must be set to look up a group.",
+		)
+		return
+	}
+
+	g, err := d.adminService.Groups.Get(groupKey).Context(ctx).Do()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
-			fmt.Sprintf("Unable to read group '%s', got error: %s", data.Name.ValueString(), err),
+			fmt.Sprintf("Unable to read group '%s', got error: %s", groupKey, formatAPIError(err)),
 		)
 		return
 	}
@@ -123,6 +167,27 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.Description = types.StringValue(g.Description)
 	data.Name = types.StringValue(g.Name)
 
+	aliases, err := listGroupAliases(ctx, d.adminService, g)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list aliases of group '%s', got error: %s", groupKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	sortByKey(aliases, canonicalKey)
+	data.Aliases = []types.String{}
+	for _, alias := range aliases {
+		data.Aliases = append(data.Aliases, types.StringValue(alias))
+	}
+
+	sortByKey(g.NonEditableAliases, canonicalKey)
+	data.NonEditableAliases = []types.String{}
+	for _, alias := range g.NonEditableAliases {
+		data.NonEditableAliases = append(data.NonEditableAliases, types.StringValue(alias))
+	}
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "read a data source")
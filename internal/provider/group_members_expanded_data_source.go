@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+const defaultExpandMaxDepth = 10
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupMembersExpandedDataSource{}
+
+func NewGroupMembersExpandedDataSource() datasource.DataSource {
+	return &GroupMembersExpandedDataSource{}
+}
+
+// GroupMembersExpandedDataSource defines the data source implementation.
+type GroupMembersExpandedDataSource struct {
+	client *http.Client
+
+	adminService *admin.Service
+}
+
+// GroupMembersExpandedDataSourceModel describes the data source data model.
+type GroupMembersExpandedDataSourceModel struct {
+	GroupKey  types.String                           `tfsdk:"group_key"`
+	MaxDepth  types.Int64                            `tfsdk:"max_depth"`
+	Members   []GroupMembersExpandedDataSourceMember `tfsdk:"members"`
+	Truncated types.Bool                             `tfsdk:"truncated"`
+	Id        types.String                           `tfsdk:"id"`
+}
+
+// Nested Model for "members".
+type GroupMembersExpandedDataSourceMember struct {
+	Email types.String   `tfsdk:"email"`
+	Path  []types.String `tfsdk:"path"`
+}
+
+func (d *GroupMembersExpandedDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members_expanded"
+}
+
+func (d *GroupMembersExpandedDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Everyone who is a member of a group, either directly or through nested
+		groups. Nested groups are resolved breadth first, so every member is reported once
+		with the shortest path by which it was included. Groups that are members of each
+		other are only expanded once.`,
+
+		Attributes: map[string]schema.Attribute{
+			"group_key": schema.StringAttribute{
+				MarkdownDescription: "The group's email address, group alias, or the unique group ID",
+				Required:            true,
+			},
+			"max_depth": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf(`How many levels of nested groups to expand, 1 only returns the
+				direct members of the group. Defaults to %d.`, defaultExpandMaxDepth),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "The members of the group and its nested groups, nested groups themselves excluded, sorted by email",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email address of the member",
+							Computed:            true,
+						},
+						"path": schema.ListAttribute{
+							MarkdownDescription: `Email addresses of the groups through which the member was
+							included, starting with the group itself and ending with the group the
+							member is a direct member of`,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Whether nested groups deeper than max_depth were left unexpanded",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier, the unique ID of the group",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GroupMembersExpandedDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.adminService = pd.adminService
+}
+
+func (d *GroupMembersExpandedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupMembersExpandedDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxDepth := int64(defaultExpandMaxDepth)
+	if !data.MaxDepth.IsNull() && !data.MaxDepth.IsUnknown() {
+		maxDepth = data.MaxDepth.ValueInt64()
+	}
+
+	groupKey := data.GroupKey.ValueString()
+
+	group, err := d.adminService.Groups.Get(groupKey).Fields("id", "email").Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read group '%s', got error: %s", groupKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	type pending struct {
+		id   string
+		path []string
+	}
+
+	// Groups are keyed by id rather than email so aliases can't make a
+	// group be expanded twice.
+	visited := map[string]bool{group.Id: true}
+	seen := map[string]bool{}
+	queue := []pending{{id: group.Id, path: []string{group.Email}}}
+
+	data.Members = []GroupMembersExpandedDataSourceMember{}
+	data.Truncated = types.BoolValue(false)
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		err := d.adminService.Members.List(current.id).MaxResults(200).Fields("nextPageToken", "members(id,email,type)").Pages(ctx, func(page *admin.Members) error {
+			for _, m := range page.Members {
+				if m.Type == "GROUP" {
+					if visited[m.Id] {
+						continue
+					}
+					if int64(len(current.path)) >= maxDepth {
+						data.Truncated = types.BoolValue(true)
+						continue
+					}
+					visited[m.Id] = true
+					path := make([]string, 0, len(current.path)+1)
+					path = append(path, current.path...)
+					queue = append(queue, pending{id: m.Id, path: append(path, m.Email)})
+					continue
+				}
+
+				// Members without an email address, such as the whole
+				// customer, can't be reported as a user.
+				if m.Email == "" || seen[canonicalKey(m.Email)] {
+					continue
+				}
+				seen[canonicalKey(m.Email)] = true
+
+				member := GroupMembersExpandedDataSourceMember{
+					Email: types.StringValue(m.Email),
+					Path:  make([]types.String, 0, len(current.path)),
+				}
+				for _, p := range current.path {
+					member.Path = append(member.Path, types.StringValue(p))
+				}
+				data.Members = append(data.Members, member)
+			}
+			return nil
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list members of group '%s', got error: %s", current.path[len(current.path)-1], formatAPIError(err)),
+			)
+			return
+		}
+	}
+
+	sortByKey(data.Members, func(m GroupMembersExpandedDataSourceMember) string { return canonicalKey(m.Email.ValueString()) })
+
+	data.MaxDepth = types.Int64Value(maxDepth)
+	data.Id = types.StringValue(group.Id)
+
+	tflog.Trace(ctx, "read expanded group members", map[string]interface{}{
+		"group_key": groupKey,
+		"groups":    len(visited),
+		"count":     len(data.Members),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
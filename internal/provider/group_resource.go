@@ -5,26 +5,38 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	admin "google.golang.org/api/admin/directory/v1"
-	"google.golang.org/api/googleapi"
-	"google.golang.org/api/option"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithModifyPlan = &GroupResource{}
+
+// Limits enforced by Google on group attributes.
+const (
+	groupNameMaxLength        = 73
+	groupDescriptionMaxLength = 300
+)
 
 func NewGroupResource() resource.Resource {
 	return &GroupResource{}
@@ -32,17 +44,39 @@ func NewGroupResource() resource.Resource {
 
 // GroupResource defines the resource implementation.
 type GroupResource struct {
-	client *http.Client
+	client     *http.Client
+	customerId string
 
 	adminService *admin.Service
+
+	readRetryTimeout             time.Duration
+	membershipPropagationTimeout time.Duration
+	maxConcurrency               int
 }
 
 // GroupResourceModel describes the resource data model.
 type GroupResourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	Email       types.String `tfsdk:"email"`
-	Description types.String `tfsdk:"description"`
-	Id          types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Email              types.String `tfsdk:"email"`
+	GroupKey           types.String `tfsdk:"group_key"`
+	Description        types.String `tfsdk:"description"`
+	Aliases            types.Set    `tfsdk:"aliases"`
+	NonEditableAliases types.List   `tfsdk:"non_editable_aliases"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	Members            types.Set    `tfsdk:"members"`
+	Etag               types.String `tfsdk:"etag"`
+	Id                 types.String `tfsdk:"id"`
+}
+
+// Nested Model for "members".
+type GroupResourceMemberModel struct {
+	Email types.String `tfsdk:"email"`
+	Role  types.String `tfsdk:"role"`
+}
+
+var groupMemberAttrTypes = map[string]attr.Type{
+	"email": types.StringType,
+	"role":  types.StringType,
 }
 
 func (g *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -56,17 +90,93 @@ func (g *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Group name",
+				MarkdownDescription: "Group name, at most 73 characters",
 				Required:            true,
+				Validators: []validator.String{
+					stringLengthAtMost(groupNameMaxLength),
+				},
 			},
 			"description": schema.StringAttribute{
-				MarkdownDescription: "Group name",
+				MarkdownDescription: "Group description, at most 300 characters",
 				Optional:            true,
+				Validators: []validator.String{
+					stringLengthAtMost(groupDescriptionMaxLength),
+				},
 			},
 			"email": schema.StringAttribute{
 				MarkdownDescription: "Group configurable attribute with default value",
 				Required:            true,
 			},
+			"group_key": schema.StringAttribute{
+				MarkdownDescription: `Canonical (lowercase) email address of the group, for APIs such as
+				group settings and members that identify groups by email rather than by id`,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aliases": schema.SetAttribute{
+				MarkdownDescription: `Additional email addresses of the group. Aliases added or removed
+				outside of Terraform are reconciled on the next apply. When not set,
+				existing aliases are left untouched.`,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"non_editable_aliases": schema.ListAttribute{
+				MarkdownDescription: `Aliases of the group that are derived from the customer's
+				domain aliases. These are maintained by Google and cannot be managed.`,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: `Adopt a group that already exists with the same email instead of
+				failing to create it, e.g. when it was created outside of Terraform. The group is
+				updated to the configured name and description. Defaults to false.`,
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: `Members of the group. Members added, removed or given another role
+				outside of Terraform are reconciled on the next apply. When not set, members are not
+				managed. Do not combine with resources managing members of the same group, such as
+				googleworkspace_cloud_identity_group_membership, as they would undo each other.`,
+				Optional: true,
+				Validators: []validator.Set{
+					setObjectsUniqueKey("email"),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email address of the member, a user or a group",
+							Required:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the member, MEMBER, MANAGER or OWNER. Defaults to MEMBER.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("MEMBER"),
+							Validators: []validator.String{
+								stringOneOf("MEMBER", "MANAGER", "OWNER"),
+							},
+						},
+					},
+				},
+			},
+			"etag": schema.StringAttribute{
+				MarkdownDescription: "ETag of the group, changes whenever the group is modified",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Group identifier",
@@ -84,25 +194,82 @@ func (g *GroupResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*http.Client)
+	pd, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	g.client = client
-	srv, err := admin.NewService(ctx, option.WithHTTPClient(client))
+	g.client = pd.client
+	g.customerId = pd.customerId
+	g.adminService = pd.adminService
+	g.readRetryTimeout = pd.readRetryTimeout
+	g.membershipPropagationTimeout = pd.membershipPropagationTimeout
+	g.maxConcurrency = pd.maxConcurrency
+}
+
+// ModifyPlan checks that the domain of a new or changed group email belongs to
+// the customer, which Google otherwise rejects with an unclear error. The
+// check is skipped when the domains can't be listed. The values derived from
+// the email are only kept from state while the email doesn't change.
+func (g *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var email, stateEmail types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("email"), &email)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("email"), &stateEmail)...)
+		resp.Diagnostics.Append(etagUnknownOnChange(ctx, req, resp)...)
+	}
+
+	if resp.Diagnostics.HasError() || canonicalKey(email.ValueString()) == canonicalKey(stateEmail.ValueString()) {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("group_key"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("non_editable_aliases"), types.ListUnknown(types.StringType))...)
+	}
+
+	// The domains can't be checked before the provider is configured.
+	if email.IsUnknown() || email.IsNull() || g.adminService == nil {
+		return
+	}
+
+	_, domain, ok := strings.Cut(email.ValueString(), "@")
+	if !ok {
+		return
+	}
+
+	domains, err := customerDomains(ctx, g.adminService, g.customerId)
 	if err != nil {
-		log.Fatalf("Unable to retrieve directory Client %v", err)
+		tflog.Warn(ctx, "Unable to list the customer's domains, skipping the group email domain check", map[string]interface{}{
+			"error": formatAPIError(err),
+		})
+		return
 	}
 
-	g.adminService = srv
+	for _, d := range domains {
+		if canonicalKey(d) == canonicalKey(domain) {
+			return
+		}
+	}
 
+	resp.Diagnostics.AddAttributeError(
+		path.Root("email"),
+		"Unknown Group Email Domain",
+		fmt.Sprintf("The domain of group email %s is not a domain of the customer. Known domains and domain aliases: %s.",
+			email.ValueString(), strings.Join(domains, ", ")),
+	)
 }
 
 func (g *GroupResource) Create(
@@ -126,18 +293,58 @@ func (g *GroupResource) Create(
 	}
 
 	res, err := g.adminService.Groups.Insert(ng).Context(ctx).Do()
+	if err != nil && isConflict(err) && data.AdoptExisting.ValueBool() {
+		// The email is a valid group key, so the existing group can be
+		// brought in line with the plan without looking up its id first.
+		tflog.Warn(ctx, "Group already exists in Google Workspace, adopting it", map[string]interface{}{
+			"email": ng.Email,
+		})
+		// Clear the description of the adopted group when none is configured.
+		if data.Description.IsNull() {
+			ng.ForceSendFields = []string{"Description"}
+		}
+		res, err = g.adminService.Groups.Update(ng.Email, ng).Context(ctx).Do()
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Google Group",
-			fmt.Sprintf("Could not create group %s: %v", data.Email.ValueString(), err),
+			fmt.Sprintf("Could not create group %s: %v", data.Email.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	// Save the group into Terraform state right away, so that it is tainted
+	// rather than orphaned when reading it back or applying its aliases and
+	// members fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), res.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), res.Email)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err = g.readCreatedGroup(ctx, res.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading created Google Group",
+			fmt.Sprintf("Group %s was created but could not be read back: %v", data.Email.ValueString(), formatAPIError(err)),
 		)
 		return
 	}
 
 	data.Id = types.StringValue(res.Id)
 	data.Email = types.StringValue(res.Email)
+	data.GroupKey = types.StringValue(canonicalKey(res.Email))
 	data.Name = types.StringValue(res.Name)
-	data.Description = types.StringValue(res.Description)
+	data.Description = groupDescriptionValue(data.Description, res.Description)
+	data.Etag = types.StringValue(res.Etag)
+
+	resp.Diagnostics.Append(g.applyAliases(ctx, res, &data)...)
+	resp.Diagnostics.Append(g.applyMembers(ctx, res, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setNonEditableAliases(ctx, &data, res)...)
 
 	tflog.Trace(ctx, "Created Google Group", map[string]interface{}{
 		"id":    res.Id,
@@ -162,19 +369,70 @@ func (g *GroupResource) Read(
 		return
 	}
 
-	ng, err := g.adminService.Groups.Get(data.Name.ValueString()).Context(ctx).Do()
+	// The display name is not a valid group key, look the group up by id so
+	// that it is still found after being renamed. Fall back to the email for
+	// state that does not have an id yet.
+	groupKey := data.Id.ValueString()
+	if groupKey == "" {
+		groupKey = data.Email.ValueString()
+	}
+
+	ng, err := g.adminService.Groups.Get(groupKey).Context(ctx).Do()
 	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Group no longer exists in Google Workspace, removing from state", map[string]interface{}{
+				"id": groupKey,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Client Error",
-			fmt.Sprintf("Unable to read group '%s', got error: %s", data.Name.ValueString(), err),
+			fmt.Sprintf("Unable to read group '%s', got error: %s", groupKey, formatAPIError(err)),
 		)
 		return
 	}
 
 	data.Id = types.StringValue(ng.Id)
 	data.Email = types.StringValue(ng.Email)
-	data.Description = types.StringValue(ng.Description)
+	data.GroupKey = types.StringValue(canonicalKey(ng.Email))
+	data.Description = groupDescriptionValue(data.Description, ng.Description)
 	data.Name = types.StringValue(ng.Name)
+	data.Etag = types.StringValue(ng.Etag)
+
+	// Imported groups have no prior value.
+	if data.AdoptExisting.IsNull() {
+		data.AdoptExisting = types.BoolValue(false)
+	}
+
+	aliases, err := listGroupAliases(ctx, g.adminService, ng)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list aliases of group '%s', got error: %s", groupKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setAliases(ctx, &data, aliases)...)
+
+	// Only list the members when they are managed, groups can have tens of
+	// thousands of them.
+	if !data.Members.IsNull() {
+		members, err := g.listMembers(ctx, ng)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list members of group '%s', got error: %s", groupKey, formatAPIError(err)),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(setMembers(ctx, &data, members)...)
+	}
+
+	resp.Diagnostics.Append(setNonEditableAliases(ctx, &data, ng)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -186,8 +444,10 @@ func (g *GroupResource) Update(
 	resp *resource.UpdateResponse,
 ) {
 	var data GroupResourceModel
+	var stateDescription types.String
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("description"), &stateDescription)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -198,20 +458,35 @@ func (g *GroupResource) Update(
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 	}
+	// An unset description is not sent, except when it was removed from the
+	// configuration, which clears it.
+	if data.Description.IsNull() && !stateDescription.IsNull() {
+		gu.ForceSendFields = []string{"Description"}
+	}
 
 	res, err := g.adminService.Groups.Update(data.Id.ValueString(), gu).Context(ctx).Do()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Google Group",
-			fmt.Sprintf("Could not update group ID %s: %v", data.Id.ValueString(), err),
+			fmt.Sprintf("Could not update group ID %s: %v", data.Id.ValueString(), formatAPIError(err)),
 		)
 		return
 	}
 
 	data.Email = types.StringValue(res.Email)
+	data.GroupKey = types.StringValue(canonicalKey(res.Email))
 	data.Name = types.StringValue(res.Name)
-	data.Description = types.StringValue(res.Description)
+	data.Description = groupDescriptionValue(data.Description, res.Description)
 	data.Id = types.StringValue(res.Id)
+	data.Etag = types.StringValue(res.Etag)
+
+	resp.Diagnostics.Append(g.applyAliases(ctx, res, &data)...)
+	resp.Diagnostics.Append(g.applyMembers(ctx, res, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setNonEditableAliases(ctx, &data, res)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -233,8 +508,7 @@ func (g *GroupResource) Delete(
 
 	err := g.adminService.Groups.Delete(data.Id.ValueString()).Context(ctx).Do()
 	if err != nil {
-		var googleErr *googleapi.Error
-		if errors.As(err, &googleErr) && googleErr.Code == 404 {
+		if isNotFound(err) {
 			// Log this for debugging purposes, but do not return an error to Terraform.
 			tflog.Warn(ctx, "Group already deleted in Google Workspace", map[string]interface{}{
 				"id": data.Id.ValueString(),
@@ -244,16 +518,430 @@ func (g *GroupResource) Delete(
 
 		resp.Diagnostics.AddError(
 			"Error Deleting Google Group",
-			fmt.Sprintf("Could not delete group ID %s: %v", data.Id.ValueString(), err),
+			fmt.Sprintf("Could not delete group ID %s: %v", data.Id.ValueString(), formatAPIError(err)),
 		)
 		return
 	}
 }
 
+// ImportState accepts either the group id or its email address, which is
+// resolved to the id since Read looks the group up by id.
 func (g *GroupResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if !strings.Contains(req.ID, "@") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	ng, err := g.adminService.Groups.Get(req.ID).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Google Group",
+			fmt.Sprintf("Could not find group with email %s: %v", req.ID, formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ng.Id)...)
+}
+
+// applyAliases reconciles the aliases of group with the planned aliases in
+// data, only inserting or deleting the aliases that differ, and stores the
+// resulting aliases in data. An unknown plan value means aliases are not
+// managed, in which case the current aliases are only read.
+func (g *GroupResource) applyAliases(ctx context.Context, group *admin.Group, data *GroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := listGroupAliases(ctx, g.adminService, group)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list aliases of group '%s', got error: %s", group.Email, formatAPIError(err)),
+		)
+		return diags
+	}
+
+	if data.Aliases.IsUnknown() || data.Aliases.IsNull() {
+		data.Aliases = types.SetNull(types.StringType)
+		diags.Append(setAliases(ctx, data, current)...)
+		return diags
+	}
+
+	var desired []string
+	diags.Append(data.Aliases.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing := map[string]string{}
+	for _, alias := range current {
+		existing[canonicalKey(alias)] = alias
+	}
+
+	wanted := map[string]bool{}
+	for _, alias := range desired {
+		key := canonicalKey(alias)
+		wanted[key] = true
+		if _, ok := existing[key]; ok {
+			continue
+		}
+
+		_, err := g.adminService.Groups.Aliases.Insert(group.Id, &admin.Alias{Alias: alias}).Context(ctx).Do()
+		if err != nil {
+			if isConflict(err) {
+				diags.AddAttributeError(
+					path.Root("aliases"),
+					"Alias Already In Use",
+					fmt.Sprintf("Could not add alias %s to group %s, the address is already used by another group or user.", alias, group.Email),
+				)
+				continue
+			}
+
+			diags.AddError(
+				"Error Adding Google Group Alias",
+				fmt.Sprintf("Could not add alias %s to group %s: %v", alias, group.Email, formatAPIError(err)),
+			)
+			continue
+		}
+
+		tflog.Trace(ctx, "Added Google Group alias", map[string]interface{}{
+			"id":    group.Id,
+			"alias": alias,
+		})
+	}
+
+	for key, alias := range existing {
+		if wanted[key] {
+			continue
+		}
+
+		err := g.adminService.Groups.Aliases.Delete(group.Id, alias).Context(ctx).Do()
+		if err != nil && !isNotFound(err) {
+			diags.AddError(
+				"Error Removing Google Group Alias",
+				fmt.Sprintf("Could not remove alias %s from group %s: %v", alias, group.Email, formatAPIError(err)),
+			)
+			continue
+		}
+
+		tflog.Trace(ctx, "Removed Google Group alias", map[string]interface{}{
+			"id":    group.Id,
+			"alias": alias,
+		})
+	}
+
+	return diags
+}
+
+// listGroupAliases returns the editable aliases of group. Aliases derived from
+// the customer's domain aliases are reported in non_editable_aliases instead.
+func listGroupAliases(ctx context.Context, adminService *admin.Service, group *admin.Group) ([]string, error) {
+	res, err := adminService.Groups.Aliases.List(group.Id).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	nonEditable := map[string]bool{}
+	for _, alias := range group.NonEditableAliases {
+		nonEditable[canonicalKey(alias)] = true
+	}
+
+	aliases := []string{}
+	for _, a := range res.Aliases {
+		// The generated client leaves the list elements undecoded.
+		m, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		alias, _ := m["alias"].(string)
+		if alias == "" || nonEditable[canonicalKey(alias)] {
+			continue
+		}
+		aliases = append(aliases, alias)
+	}
+
+	return aliases, nil
+}
+
+// customerDomains returns the names of the domains and domain aliases of the
+// customer.
+func customerDomains(ctx context.Context, adminService *admin.Service, customer string) ([]string, error) {
+	res, err := adminService.Domains.List(customer).Fields("domains(domainName,domainAliases(domainAliasName))").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var domains []string
+	for _, d := range res.Domains {
+		domains = append(domains, d.DomainName)
+		for _, a := range d.DomainAliases {
+			domains = append(domains, a.DomainAliasName)
+		}
+	}
+
+	return domains, nil
+}
+
+// groupDescriptionValue returns the description Google reports for a group.
+// Google doesn't tell an unset description apart from an empty one, an empty
+// description stays null when it was null before.
+func groupDescriptionValue(prior types.String, description string) types.String {
+	if description == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(description)
+}
+
+// setAliases stores aliases in data, keeping the casing of aliases that are
+// already in data so that Google normalizing them doesn't cause a diff.
+func setAliases(ctx context.Context, data *GroupResourceModel, aliases []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured := map[string]string{}
+	if !data.Aliases.IsNull() && !data.Aliases.IsUnknown() {
+		var prior []string
+		diags.Append(data.Aliases.ElementsAs(ctx, &prior, false)...)
+		for _, alias := range prior {
+			configured[canonicalKey(alias)] = alias
+		}
+	}
+
+	values := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		if c, ok := configured[canonicalKey(alias)]; ok {
+			alias = c
+		}
+		values = append(values, alias)
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	data.Aliases = set
+
+	return diags
+}
+
+// readCreatedGroup reads the group with the given id. The Directory API is
+// eventually consistent, so a new group is re-read while it is not found, up
+// to the read_retry_timeout, before it is handed to dependent resources.
+func (g *GroupResource) readCreatedGroup(ctx context.Context, id string) (*admin.Group, error) {
+	return readWithRetry(ctx, g.readRetryTimeout, func(ctx context.Context) (*admin.Group, error) {
+		return g.adminService.Groups.Get(id).Context(ctx).Do()
+	})
+}
+
+// applyMembers reconciles the members of group with the planned members in
+// data, only inserting, updating or deleting the members that differ. The
+// current members are streamed page by page and only the differences are
+// kept, so large groups are never held in memory. A null plan value means
+// members are not managed.
+func (g *GroupResource) applyMembers(ctx context.Context, group *admin.Group, data *GroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Members.IsNull() || data.Members.IsUnknown() {
+		return diags
+	}
+
+	var desired []GroupResourceMemberModel
+	diags.Append(data.Members.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	wanted := map[string]GroupResourceMemberModel{}
+	for _, m := range desired {
+		wanted[canonicalKey(m.Email.ValueString())] = m
+	}
+
+	// Changes are applied once every page has been read, deleting members
+	// while paging could make the listing skip members.
+	var toDelete []string
+	var toUpdate []GroupResourceMemberModel
+	found := map[string]bool{}
+	err := g.adminService.Members.List(group.Id).MaxResults(200).Fields("nextPageToken", "members(email,role)").Pages(ctx, func(page *admin.Members) error {
+		for _, m := range page.Members {
+			// Members such as the whole customer have no email address and
+			// cannot be configured, leave them alone.
+			if m.Email == "" {
+				continue
+			}
+
+			key := canonicalKey(m.Email)
+			w, ok := wanted[key]
+			if !ok {
+				toDelete = append(toDelete, m.Email)
+				continue
+			}
+
+			found[key] = true
+			if w.Role.ValueString() != m.Role {
+				toUpdate = append(toUpdate, w)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list members of group '%s', got error: %s", group.Email, formatAPIError(err)),
+		)
+		return diags
+	}
+
+	var toInsert []GroupResourceMemberModel
+	for _, m := range desired {
+		if !found[canonicalKey(m.Email.ValueString())] {
+			toInsert = append(toInsert, m)
+		}
+	}
+
+	// Groups can have thousands of members, so the changes are sent
+	// concurrently rather than one request at a time.
+	diags.Append(runConcurrently(ctx, g.maxConcurrency, toInsert, func(ctx context.Context, m GroupResourceMemberModel) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		_, err := g.adminService.Members.Insert(group.Id, &admin.Member{
+			Email: m.Email.ValueString(),
+			Role:  m.Role.ValueString(),
+		}).Context(ctx).Do()
+		if err != nil {
+			diags.AddError(
+				"Error Adding Google Group Member",
+				fmt.Sprintf("Could not add member %s to group %s: %v", m.Email.ValueString(), group.Email, formatAPIError(err)),
+			)
+			return diags
+		}
+
+		tflog.Trace(ctx, "Added Google Group member", map[string]interface{}{
+			"id":     group.Id,
+			"member": m.Email.ValueString(),
+		})
+
+		// A new member can be reported as not found for a while, wait for it
+		// so that the group is read back with it.
+		_, err = readWithRetry(ctx, g.membershipPropagationTimeout, func(ctx context.Context) (*admin.Member, error) {
+			return g.adminService.Members.Get(group.Id, m.Email.ValueString()).Context(ctx).Do()
+		})
+		if err != nil {
+			diags.AddError(
+				"Error Reading Google Group Member",
+				fmt.Sprintf("Member %s was added to group %s but could not be read back: %v", m.Email.ValueString(), group.Email, formatAPIError(err)),
+			)
+		}
+
+		return diags
+	})...)
+
+	diags.Append(runConcurrently(ctx, g.maxConcurrency, toUpdate, func(ctx context.Context, m GroupResourceMemberModel) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		_, err := g.adminService.Members.Patch(group.Id, m.Email.ValueString(), &admin.Member{
+			Role: m.Role.ValueString(),
+		}).Context(ctx).Do()
+		if err != nil {
+			diags.AddError(
+				"Error Updating Google Group Member",
+				fmt.Sprintf("Could not set role of member %s of group %s to %s: %v", m.Email.ValueString(), group.Email, m.Role.ValueString(), formatAPIError(err)),
+			)
+			return diags
+		}
+
+		tflog.Trace(ctx, "Updated Google Group member", map[string]interface{}{
+			"id":     group.Id,
+			"member": m.Email.ValueString(),
+			"role":   m.Role.ValueString(),
+		})
+
+		return diags
+	})...)
+
+	diags.Append(runConcurrently(ctx, g.maxConcurrency, toDelete, func(ctx context.Context, email string) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		err := g.adminService.Members.Delete(group.Id, email).Context(ctx).Do()
+		if err != nil && !isNotFound(err) {
+			diags.AddError(
+				"Error Removing Google Group Member",
+				fmt.Sprintf("Could not remove member %s from group %s: %v", email, group.Email, formatAPIError(err)),
+			)
+			return diags
+		}
+
+		tflog.Trace(ctx, "Removed Google Group member", map[string]interface{}{
+			"id":     group.Id,
+			"member": email,
+		})
+
+		return diags
+	})...)
+
+	return diags
+}
+
+// listMembers returns the members of group that have an email address.
+func (g *GroupResource) listMembers(ctx context.Context, group *admin.Group) ([]*admin.Member, error) {
+	var members []*admin.Member
+	err := g.adminService.Members.List(group.Id).MaxResults(200).Fields("nextPageToken", "members(email,role)").Pages(ctx, func(page *admin.Members) error {
+		for _, m := range page.Members {
+			if m.Email != "" {
+				members = append(members, m)
+			}
+		}
+		return nil
+	})
+
+	return members, err
+}
+
+// setNonEditableAliases stores the non-editable aliases of group in data. A
+// group without any has an empty list rather than a null one, which Google
+// leaves out of the response.
+func setNonEditableAliases(ctx context.Context, data *GroupResourceModel, group *admin.Group) diag.Diagnostics {
+	aliases := group.NonEditableAliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, aliases)
+	data.NonEditableAliases = list
+
+	return diags
+}
+
+// setMembers stores members in data, keeping the casing of email addresses
+// that are already in data so that Google normalizing them doesn't cause a
+// diff.
+func setMembers(ctx context.Context, data *GroupResourceModel, members []*admin.Member) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured := map[string]string{}
+	if !data.Members.IsNull() && !data.Members.IsUnknown() {
+		var prior []GroupResourceMemberModel
+		diags.Append(data.Members.ElementsAs(ctx, &prior, false)...)
+		for _, m := range prior {
+			configured[canonicalKey(m.Email.ValueString())] = m.Email.ValueString()
+		}
+	}
+
+	values := make([]GroupResourceMemberModel, 0, len(members))
+	for _, m := range members {
+		email := m.Email
+		if c, ok := configured[canonicalKey(email)]; ok {
+			email = c
+		}
+		values = append(values, GroupResourceMemberModel{
+			Email: types.StringValue(email),
+			Role:  types.StringValue(m.Role),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: groupMemberAttrTypes}, values)
+	diags.Append(d...)
+	data.Members = set
+
+	return diags
 }
@@ -3,6 +3,88 @@
 
 package provider
 
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+func TestGroupResourceReadCreatedGroup(t *testing.T) {
+	tests := map[string]struct {
+		notFound  int
+		timeout   time.Duration
+		wantReads int
+		wantErr   bool
+		wantEmail string
+	}{
+		"404 once then found": {
+			notFound:  1,
+			timeout:   time.Minute,
+			wantReads: 2,
+			wantEmail: "team@example.com",
+		},
+		"zero read_retry_timeout reads once": {
+			notFound:  1,
+			timeout:   0,
+			wantReads: 1,
+			wantErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reads := 0
+			srv := newTestAdminService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/admin/directory/v1/groups/123" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+					return
+				}
+
+				reads++
+				w.Header().Set("Content-Type", "application/json")
+				if reads <= test.notFound {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Resource Not Found: groupKey"}}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":"123","email":"team@example.com"}`))
+			}))
+
+			g := &GroupResource{adminService: srv, readRetryTimeout: test.timeout}
+
+			res, err := g.readCreatedGroup(context.Background(), "123")
+
+			if reads != test.wantReads {
+				t.Errorf("got %d reads, want %d", reads, test.wantReads)
+			}
+			if test.wantErr {
+				if !isNotFound(err) {
+					t.Errorf("got error %v, want a 404", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if res.Email != test.wantEmail {
+				t.Errorf("got email %q, want %q", res.Email, test.wantEmail)
+			}
+		})
+	}
+}
+
 //TODO: Fix tests
 //func TestAccGroupResource(t *testing.T) {
 //	//	resource.Test(t, resource.TestCase{
@@ -75,3 +157,573 @@ package provider
 //}
 //`, name, email)
 //}
+
+func TestGroupResourceAliasesUseStateForUnknown(t *testing.T) {
+	ctx := context.Background()
+	r := &GroupResource{}
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+	aliases, ok := resp.Schema.Attributes["aliases"].(schema.SetAttribute)
+	if !ok {
+		t.Fatalf("got attribute of type %T, want a schema.SetAttribute", resp.Schema.Attributes["aliases"])
+	}
+
+	state := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("alias@example.com")})
+	req := planmodifier.SetRequest{
+		State: newTestResourceState(t, r, GroupResourceModel{
+			Name:               types.StringValue("Team"),
+			Email:              types.StringValue("team@example.com"),
+			GroupKey:           types.StringNull(),
+			Description:        types.StringNull(),
+			Aliases:            state,
+			NonEditableAliases: types.ListNull(types.StringType),
+			AdoptExisting:      types.BoolValue(false),
+			Members:            types.SetNull(types.ObjectType{AttrTypes: groupMemberAttrTypes}),
+			Etag:               types.StringNull(),
+			Id:                 types.StringValue("123"),
+		}),
+		StateValue:  state,
+		PlanValue:   types.SetUnknown(types.StringType),
+		ConfigValue: types.SetNull(types.StringType),
+	}
+	modifyResp := &planmodifier.SetResponse{PlanValue: req.PlanValue}
+	for _, m := range aliases.PlanModifiers {
+		m.PlanModifySet(ctx, req, modifyResp)
+	}
+
+	if !modifyResp.PlanValue.Equal(state) {
+		t.Errorf("got planned aliases %s, want %s", modifyResp.PlanValue, state)
+	}
+}
+
+func TestGroupDescriptionValue(t *testing.T) {
+	tests := map[string]struct {
+		prior       types.String
+		description string
+		want        types.String
+	}{
+		"unset stays null": {
+			prior: types.StringNull(),
+			want:  types.StringNull(),
+		},
+		"empty stays empty": {
+			prior: types.StringValue(""),
+			want:  types.StringValue(""),
+		},
+		"cleared outside of Terraform": {
+			prior: types.StringValue("Team"),
+			want:  types.StringValue(""),
+		},
+		"set outside of Terraform": {
+			prior:       types.StringNull(),
+			description: "Team",
+			want:        types.StringValue("Team"),
+		},
+		"unknown on create": {
+			prior:       types.StringUnknown(),
+			description: "",
+			want:        types.StringValue(""),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := groupDescriptionValue(test.prior, test.description)
+			if !got.Equal(test.want) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+// testGroupPlan returns the planned model of a new group.
+func testGroupPlan() GroupResourceModel {
+	return GroupResourceModel{
+		Name:               types.StringValue("Team"),
+		Email:              types.StringValue("team@example.com"),
+		GroupKey:           types.StringUnknown(),
+		Description:        types.StringNull(),
+		Aliases:            types.SetUnknown(types.StringType),
+		NonEditableAliases: types.ListUnknown(types.StringType),
+		AdoptExisting:      types.BoolValue(false),
+		Members:            types.SetNull(types.ObjectType{AttrTypes: groupMemberAttrTypes}),
+		Etag:               types.StringUnknown(),
+		Id:                 types.StringUnknown(),
+	}
+}
+
+func TestGroupResourceCreateSavesCreatedGroup(t *testing.T) {
+	group := testAPIResponse{http.StatusOK, `{"id":"123","email":"team@example.com","name":"Team","etag":"e1"}`}
+	noAliases := testAPIResponse{http.StatusOK, `{"aliases":[]}`}
+	failed := testAPIResponse{http.StatusInternalServerError, `{"error":{"code":500,"message":"Backend Error"}}`}
+
+	member := types.ObjectValueMust(groupMemberAttrTypes, map[string]attr.Value{
+		"email": types.StringValue("jdoe@example.com"),
+		"role":  types.StringValue("MEMBER"),
+	})
+
+	tests := map[string]struct {
+		update    func(data *GroupResourceModel)
+		responses map[string]testAPIResponse
+		wantErr   bool
+	}{
+		"created": {
+			responses: map[string]testAPIResponse{
+				"GET /admin/directory/v1/groups/123":         group,
+				"GET /admin/directory/v1/groups/123/aliases": noAliases,
+			},
+		},
+		"read back fails": {
+			responses: map[string]testAPIResponse{
+				"GET /admin/directory/v1/groups/123": failed,
+			},
+			wantErr: true,
+		},
+		"adding an alias fails": {
+			update: func(data *GroupResourceModel) {
+				data.Aliases = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("alias@example.com")})
+			},
+			responses: map[string]testAPIResponse{
+				"GET /admin/directory/v1/groups/123":          group,
+				"GET /admin/directory/v1/groups/123/aliases":  noAliases,
+				"POST /admin/directory/v1/groups/123/aliases": failed,
+			},
+			wantErr: true,
+		},
+		"adding a member fails": {
+			update: func(data *GroupResourceModel) {
+				data.Members = types.SetValueMust(types.ObjectType{AttrTypes: groupMemberAttrTypes}, []attr.Value{member})
+			},
+			responses: map[string]testAPIResponse{
+				"GET /admin/directory/v1/groups/123":          group,
+				"GET /admin/directory/v1/groups/123/aliases":  noAliases,
+				"GET /admin/directory/v1/groups/123/members":  {http.StatusOK, `{}`},
+				"POST /admin/directory/v1/groups/123/members": failed,
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			test.responses["POST /admin/directory/v1/groups"] = testAPIResponse{http.StatusOK, `{"id":"123","email":"team@example.com"}`}
+
+			var requests []string
+			g := &GroupResource{adminService: newTestAdminService(t, testAPIHandler(t, test.responses, &requests))}
+
+			data := testGroupPlan()
+			if test.update != nil {
+				test.update(&data)
+			}
+
+			plan := newTestResourcePlan(t, g, data)
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+			}
+			g.Create(ctx, resource.CreateRequest{Plan: plan, Config: tfsdk.Config(plan)}, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", resp.Diagnostics, test.wantErr)
+			}
+
+			var got GroupResourceModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("unexpected error reading the state: %v", diags)
+			}
+			if got.Id.ValueString() != "123" || got.Email.ValueString() != "team@example.com" {
+				t.Errorf("got id %s and email %s in state, want the created group to be saved", got.Id, got.Email)
+			}
+			if !test.wantErr && got.Etag.ValueString() != "e1" {
+				t.Errorf("got etag %s, want e1", got.Etag)
+			}
+		})
+	}
+}
+
+func TestGroupResourceApplyMembersWaitsForNewMembers(t *testing.T) {
+	tests := map[string]struct {
+		notFound  int
+		timeout   time.Duration
+		wantReads int
+		wantErr   bool
+	}{
+		"404 once then found": {
+			notFound:  1,
+			timeout:   time.Minute,
+			wantReads: 2,
+		},
+		"zero membership_propagation_timeout reads once": {
+			notFound:  1,
+			timeout:   0,
+			wantReads: 1,
+			wantErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			reads, inserts := 0, 0
+			srv := newTestAdminService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method + " " + r.URL.Path {
+				case "GET /admin/directory/v1/groups/123/members":
+					_, _ = w.Write([]byte(`{}`))
+				case "POST /admin/directory/v1/groups/123/members":
+					inserts++
+					_, _ = w.Write([]byte(`{"email":"jdoe@example.com","role":"MEMBER"}`))
+				case "GET /admin/directory/v1/groups/123/members/jdoe@example.com":
+					reads++
+					if reads <= test.notFound {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Resource Not Found: memberKey"}}`))
+						return
+					}
+					_, _ = w.Write([]byte(`{"email":"jdoe@example.com","role":"MEMBER"}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+				}
+			}))
+
+			g := &GroupResource{adminService: srv, membershipPropagationTimeout: test.timeout, maxConcurrency: 1}
+
+			data := testGroupPlan()
+			data.Members = types.SetValueMust(types.ObjectType{AttrTypes: groupMemberAttrTypes}, []attr.Value{
+				types.ObjectValueMust(groupMemberAttrTypes, map[string]attr.Value{
+					"email": types.StringValue("jdoe@example.com"),
+					"role":  types.StringValue("MEMBER"),
+				}),
+			})
+
+			diags := g.applyMembers(ctx, &admin.Group{Id: "123", Email: "team@example.com"}, &data)
+
+			if diags.HasError() != test.wantErr {
+				t.Errorf("got diagnostics %v, want error %t", diags, test.wantErr)
+			}
+			if inserts != 1 {
+				t.Errorf("got %d inserts, want 1", inserts)
+			}
+			if reads != test.wantReads {
+				t.Errorf("got %d reads, want %d", reads, test.wantReads)
+			}
+		})
+	}
+}
+
+func TestGroupResourceReadEtag(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	g := &GroupResource{adminService: newTestAdminService(t, testAPIHandler(t, map[string]testAPIResponse{
+		"GET /admin/directory/v1/groups/123":         {http.StatusOK, `{"id":"123","email":"team@example.com","name":"Team","etag":"e2"}`},
+		"GET /admin/directory/v1/groups/123/aliases": {http.StatusOK, `{"aliases":[]}`},
+	}, &requests))}
+
+	data := testGroupPlan()
+	data.GroupKey = types.StringValue("team@example.com")
+	data.Aliases = types.SetValueMust(types.StringType, nil)
+	data.NonEditableAliases = types.ListValueMust(types.StringType, nil)
+	data.Etag = types.StringValue("e1")
+	data.Id = types.StringValue("123")
+
+	state := newTestResourceState(t, g, data)
+	resp := &resource.ReadResponse{State: state}
+	g.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got GroupResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.Etag.ValueString() != "e2" {
+		t.Errorf("got etag %s after read, want e2", got.Etag)
+	}
+}
+
+func TestGroupResourceReadRenamedGroup(t *testing.T) {
+	ctx := context.Background()
+
+	// The group was renamed outside of Terraform, its old email is gone.
+	var requests []string
+	g := &GroupResource{adminService: newTestAdminService(t, testAPIHandler(t, map[string]testAPIResponse{
+		"GET /admin/directory/v1/groups/old@example.com": {http.StatusNotFound, `{"error":{"code":404,"message":"Resource Not Found: groupKey"}}`},
+		"GET /admin/directory/v1/groups/123":             {http.StatusOK, `{"id":"123","email":"new@example.com","name":"Team","etag":"e2"}`},
+		"GET /admin/directory/v1/groups/123/aliases":     {http.StatusOK, `{"aliases":[]}`},
+	}, &requests))}
+
+	data := testGroupPlan()
+	data.Email = types.StringValue("old@example.com")
+	data.GroupKey = types.StringValue("old@example.com")
+	data.Aliases = types.SetValueMust(types.StringType, nil)
+	data.NonEditableAliases = types.ListValueMust(types.StringType, nil)
+	data.Etag = types.StringValue("e1")
+	data.Id = types.StringValue("123")
+
+	state := newTestResourceState(t, g, data)
+	resp := &resource.ReadResponse{State: state}
+	g.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if resp.State.Raw.IsNull() {
+		t.Fatal("got the group removed from state, want it to be found by id")
+	}
+
+	var got GroupResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.Email.ValueString() != "new@example.com" || got.GroupKey.ValueString() != "new@example.com" {
+		t.Errorf("got email %s and group_key %s, want new@example.com", got.Email, got.GroupKey)
+	}
+	for _, request := range requests {
+		if request == "GET /admin/directory/v1/groups/old@example.com" {
+			t.Errorf("got request %s, want the group to be read by id", request)
+		}
+	}
+}
+
+func TestGroupResourceCreateAdoptExisting(t *testing.T) {
+	exists := testAPIResponse{http.StatusConflict, `{"error":{"code":409,"message":"Entity already exists."}}`}
+	group := testAPIResponse{http.StatusOK, `{"id":"123","email":"team@example.com","name":"Team","etag":"e1"}`}
+
+	tests := map[string]struct {
+		adoptExisting bool
+		wantErr       bool
+		wantUpdate    bool
+	}{
+		"adopted": {
+			adoptExisting: true,
+			wantUpdate:    true,
+		},
+		"not adopted": {
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var requests []string
+			g := &GroupResource{adminService: newTestAdminService(t, testAPIHandler(t, map[string]testAPIResponse{
+				"POST /admin/directory/v1/groups":                 exists,
+				"PUT /admin/directory/v1/groups/team@example.com": group,
+				"GET /admin/directory/v1/groups/123":              group,
+				"GET /admin/directory/v1/groups/123/aliases":      {http.StatusOK, `{"aliases":[]}`},
+			}, &requests))}
+
+			data := testGroupPlan()
+			data.AdoptExisting = types.BoolValue(test.adoptExisting)
+
+			plan := newTestResourcePlan(t, g, data)
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+			}
+			g.Create(ctx, resource.CreateRequest{Plan: plan, Config: tfsdk.Config(plan)}, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", resp.Diagnostics, test.wantErr)
+			}
+
+			updated := false
+			for _, request := range requests {
+				updated = updated || request == "PUT /admin/directory/v1/groups/team@example.com"
+			}
+			if updated != test.wantUpdate {
+				t.Errorf("got requests %v, want the existing group updated by email %t", requests, test.wantUpdate)
+			}
+
+			if test.wantErr {
+				if !resp.State.Raw.IsNull() {
+					t.Errorf("got state %v, want no group saved", resp.State.Raw)
+				}
+				return
+			}
+
+			var got GroupResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.Id.ValueString() != "123" || got.Etag.ValueString() != "e1" {
+				t.Errorf("got id %s and etag %s in state, want the adopted group", got.Id, got.Etag)
+			}
+		})
+	}
+}
+
+func TestGroupResourceImportState(t *testing.T) {
+	tests := map[string]struct {
+		id      string
+		wantId  string
+		wantErr bool
+	}{
+		"by id": {
+			id:     "123",
+			wantId: "123",
+		},
+		"by email": {
+			id:     "team@example.com",
+			wantId: "123",
+		},
+		"unknown email": {
+			id:      "missing@example.com",
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var requests []string
+			g := &GroupResource{adminService: newTestAdminService(t, testAPIHandler(t, map[string]testAPIResponse{
+				"GET /admin/directory/v1/groups/team@example.com":    {http.StatusOK, `{"id":"123","email":"team@example.com","name":"Team"}`},
+				"GET /admin/directory/v1/groups/missing@example.com": {http.StatusNotFound, `{"error":{"code":404,"message":"Resource Not Found: groupKey"}}`},
+			}, &requests))}
+
+			plan := newTestResourcePlan(t, g, testGroupPlan())
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+			}
+			g.ImportState(ctx, resource.ImportStateRequest{ID: test.id}, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", resp.Diagnostics, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if id.ValueString() != test.wantId {
+				t.Errorf("got id %s, want %s", id, test.wantId)
+			}
+			if test.id == test.wantId && len(requests) > 0 {
+				t.Errorf("got requests %v, want an id to be imported as is", requests)
+			}
+		})
+	}
+}
+
+// testGroupState returns the model of a group as stored in state.
+func testGroupState() GroupResourceModel {
+	data := testGroupPlan()
+	data.GroupKey = types.StringValue("team@example.com")
+	data.Aliases = types.SetValueMust(types.StringType, nil)
+	data.NonEditableAliases = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("team@example.net")})
+	data.Etag = types.StringValue("e1")
+	data.Id = types.StringValue("123")
+	return data
+}
+
+func TestGroupResourceModifyPlanComputedValues(t *testing.T) {
+	tests := map[string]struct {
+		update                 func(data *GroupResourceModel)
+		wantEtag               types.String
+		wantGroupKey           types.String
+		wantNonEditableAliases types.List
+	}{
+		"unchanged": {
+			wantEtag:               types.StringValue("e1"),
+			wantGroupKey:           types.StringValue("team@example.com"),
+			wantNonEditableAliases: testGroupState().NonEditableAliases,
+		},
+		"description changed": {
+			update: func(data *GroupResourceModel) {
+				data.Description = types.StringValue("The team")
+			},
+			wantEtag:               types.StringUnknown(),
+			wantGroupKey:           types.StringValue("team@example.com"),
+			wantNonEditableAliases: testGroupState().NonEditableAliases,
+		},
+		"email changed": {
+			update: func(data *GroupResourceModel) {
+				data.Email = types.StringValue("squad@example.com")
+			},
+			wantEtag:               types.StringUnknown(),
+			wantGroupKey:           types.StringUnknown(),
+			wantNonEditableAliases: types.ListUnknown(types.StringType),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			g := &GroupResource{}
+
+			// The plan as it is after UseStateForUnknown.
+			data := testGroupState()
+			if test.update != nil {
+				test.update(&data)
+			}
+
+			plan := newTestResourcePlan(t, g, data)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			g.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:   plan,
+				State:  newTestResourceState(t, g, testGroupState()),
+				Config: newTestResourceConfig(t, g, data),
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got GroupResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+			if !got.Etag.Equal(test.wantEtag) {
+				t.Errorf("got planned etag %s, want %s", got.Etag, test.wantEtag)
+			}
+			if !got.GroupKey.Equal(test.wantGroupKey) {
+				t.Errorf("got planned group_key %s, want %s", got.GroupKey, test.wantGroupKey)
+			}
+			if !got.NonEditableAliases.Equal(test.wantNonEditableAliases) {
+				t.Errorf("got planned non_editable_aliases %s, want %s", got.NonEditableAliases, test.wantNonEditableAliases)
+			}
+		})
+	}
+}
+
+func TestGroupResourceReadNonEditableAliases(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		want types.List
+	}{
+		"domain alias": {
+			body: `{"id":"123","email":"team@example.com","name":"Team","nonEditableAliases":["team@example.net"]}`,
+			want: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("team@example.net")}),
+		},
+		"none": {
+			body: `{"id":"123","email":"team@example.com","name":"Team"}`,
+			want: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var requests []string
+			g := &GroupResource{adminService: newTestAdminService(t, testAPIHandler(t, map[string]testAPIResponse{
+				"GET /admin/directory/v1/groups/123":         {http.StatusOK, test.body},
+				"GET /admin/directory/v1/groups/123/aliases": {http.StatusOK, `{"aliases":[]}`},
+			}, &requests))}
+
+			state := newTestResourceState(t, g, testGroupState())
+			resp := &resource.ReadResponse{State: state}
+			g.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got GroupResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if !got.NonEditableAliases.Equal(test.want) {
+				t.Errorf("got non_editable_aliases %s, want %s", got.NonEditableAliases, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/groupssettings/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupSettingsDataSource{}
+
+func NewGroupSettingsDataSource() datasource.DataSource {
+	return &GroupSettingsDataSource{}
+}
+
+// GroupSettingsDataSource defines the data source implementation.
+type GroupSettingsDataSource struct {
+	client *http.Client
+
+	groupssettingsService *groupssettings.Service
+}
+
+// GroupSettingsDataSourceModel describes the data source data model.
+type GroupSettingsDataSourceModel struct {
+	Email                              types.String `tfsdk:"email"`
+	MergeWithDefaults                  types.Bool   `tfsdk:"merge_with_defaults"`
+	Name                               types.String `tfsdk:"name"`
+	Description                        types.String `tfsdk:"description"`
+	WhoCanJoin                         types.String `tfsdk:"who_can_join"`
+	WhoCanViewMembership               types.String `tfsdk:"who_can_view_membership"`
+	WhoCanViewGroup                    types.String `tfsdk:"who_can_view_group"`
+	WhoCanPostMessage                  types.String `tfsdk:"who_can_post_message"`
+	WhoCanContactOwner                 types.String `tfsdk:"who_can_contact_owner"`
+	WhoCanDiscoverGroup                types.String `tfsdk:"who_can_discover_group"`
+	WhoCanLeaveGroup                   types.String `tfsdk:"who_can_leave_group"`
+	WhoCanModerateMembers              types.String `tfsdk:"who_can_moderate_members"`
+	WhoCanModerateContent              types.String `tfsdk:"who_can_moderate_content"`
+	WhoCanAssistContent                types.String `tfsdk:"who_can_assist_content"`
+	MessageModerationLevel             types.String `tfsdk:"message_moderation_level"`
+	SpamModerationLevel                types.String `tfsdk:"spam_moderation_level"`
+	ReplyTo                            types.String `tfsdk:"reply_to"`
+	CustomReplyTo                      types.String `tfsdk:"custom_reply_to"`
+	DefaultSender                      types.String `tfsdk:"default_sender"`
+	CustomFooterText                   types.String `tfsdk:"custom_footer_text"`
+	DefaultMessageDenyNotificationText types.String `tfsdk:"default_message_deny_notification_text"`
+	PrimaryLanguage                    types.String `tfsdk:"primary_language"`
+	AllowExternalMembers               types.Bool   `tfsdk:"allow_external_members"`
+	AllowWebPosting                    types.Bool   `tfsdk:"allow_web_posting"`
+	IsArchived                         types.Bool   `tfsdk:"is_archived"`
+	ArchiveOnly                        types.Bool   `tfsdk:"archive_only"`
+	MembersCanPostAsTheGroup           types.Bool   `tfsdk:"members_can_post_as_the_group"`
+	IncludeInGlobalAddressList         types.Bool   `tfsdk:"include_in_global_address_list"`
+	IncludeCustomFooter                types.Bool   `tfsdk:"include_custom_footer"`
+	SendMessageDenyNotification        types.Bool   `tfsdk:"send_message_deny_notification"`
+	EnableCollaborativeInbox           types.Bool   `tfsdk:"enable_collaborative_inbox"`
+	Id                                 types.String `tfsdk:"id"`
+}
+
+func (d *GroupSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_settings"
+}
+
+func (d *GroupSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Current settings of a group, e.g. to compare them with a configuration
+		before managing them with the googleworkspace_group_settings resource. The values are
+		the enums returned by the Groups Settings API.` + scopesDescription(groupssettings.AppsGroupsSettingsScope),
+
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the group",
+				Required:            true,
+			},
+			"merge_with_defaults": schema.BoolAttribute{
+				MarkdownDescription: `Whether settings Google leaves empty are returned with the default
+				values the googleworkspace_group_settings resource applies, rather than empty.
+				Defaults to false.`,
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The group's display name",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The group's description",
+				Computed:            true,
+			},
+			"who_can_join": schema.StringAttribute{
+				MarkdownDescription: "Permission to join the group",
+				Computed:            true,
+			},
+			"who_can_view_membership": schema.StringAttribute{
+				MarkdownDescription: "Permission to view the group's members",
+				Computed:            true,
+			},
+			"who_can_view_group": schema.StringAttribute{
+				MarkdownDescription: "Permission to view the group's messages",
+				Computed:            true,
+			},
+			"who_can_post_message": schema.StringAttribute{
+				MarkdownDescription: "Permission to post messages",
+				Computed:            true,
+			},
+			"who_can_contact_owner": schema.StringAttribute{
+				MarkdownDescription: "Permission to contact the owners of the group",
+				Computed:            true,
+			},
+			"who_can_discover_group": schema.StringAttribute{
+				MarkdownDescription: "Who can find the group in the directory",
+				Computed:            true,
+			},
+			"who_can_leave_group": schema.StringAttribute{
+				MarkdownDescription: "Permission to leave the group",
+				Computed:            true,
+			},
+			"who_can_moderate_members": schema.StringAttribute{
+				MarkdownDescription: "Who can manage members",
+				Computed:            true,
+			},
+			"who_can_moderate_content": schema.StringAttribute{
+				MarkdownDescription: "Who can moderate content",
+				Computed:            true,
+			},
+			"who_can_assist_content": schema.StringAttribute{
+				MarkdownDescription: "Who can moderate metadata",
+				Computed:            true,
+			},
+			"message_moderation_level": schema.StringAttribute{
+				MarkdownDescription: "Moderation level of incoming messages",
+				Computed:            true,
+			},
+			"spam_moderation_level": schema.StringAttribute{
+				MarkdownDescription: "How messages suspected to be spam are handled",
+				Computed:            true,
+			},
+			"reply_to": schema.StringAttribute{
+				MarkdownDescription: "Who the default reply of a message goes to",
+				Computed:            true,
+			},
+			"custom_reply_to": schema.StringAttribute{
+				MarkdownDescription: "Email address replies go to when reply_to is REPLY_TO_CUSTOM",
+				Computed:            true,
+			},
+			"default_sender": schema.StringAttribute{
+				MarkdownDescription: "Default sender of messages posted from the web, DEFAULT_SELF or GROUP",
+				Computed:            true,
+			},
+			"custom_footer_text": schema.StringAttribute{
+				MarkdownDescription: "Text of the custom footer added to messages",
+				Computed:            true,
+			},
+			"default_message_deny_notification_text": schema.StringAttribute{
+				MarkdownDescription: "Text of the notification sent to the author of a rejected message",
+				Computed:            true,
+			},
+			"primary_language": schema.StringAttribute{
+				MarkdownDescription: "Primary language of the group",
+				Computed:            true,
+			},
+			"allow_external_members": schema.BoolAttribute{
+				MarkdownDescription: "Whether members external to the organization can join the group",
+				Computed:            true,
+			},
+			"allow_web_posting": schema.BoolAttribute{
+				MarkdownDescription: "Whether members can post from the web",
+				Computed:            true,
+			},
+			"is_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the contents of the group are archived",
+				Computed:            true,
+			},
+			"archive_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether the group is archive only, i.e. inactive",
+				Computed:            true,
+			},
+			"members_can_post_as_the_group": schema.BoolAttribute{
+				MarkdownDescription: "Whether members can post using the group email address",
+				Computed:            true,
+			},
+			"include_in_global_address_list": schema.BoolAttribute{
+				MarkdownDescription: "Whether the group is included in the Global Address List",
+				Computed:            true,
+			},
+			"include_custom_footer": schema.BoolAttribute{
+				MarkdownDescription: "Whether the custom footer is added to messages",
+				Computed:            true,
+			},
+			"send_message_deny_notification": schema.BoolAttribute{
+				MarkdownDescription: "Whether the author of a rejected message is notified",
+				Computed:            true,
+			},
+			"enable_collaborative_inbox": schema.BoolAttribute{
+				MarkdownDescription: "Whether the collaborative inbox is enabled",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Email address of the group",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GroupSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.groupssettingsService = pd.groupssettingsService
+}
+
+func (d *GroupSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupSettingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	g, err := d.groupssettingsService.Groups.Get(data.Email.ValueString()).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read settings of group '%s', got error: %s", data.Email.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	if data.MergeWithDefaults.ValueBool() {
+		g = withGroupSettingsDefaults(g)
+	}
+
+	flattenGroupSettingsDataSource(g, &data)
+	data.Id = data.Email
+
+	tflog.Trace(ctx, "read group settings", map[string]interface{}{
+		"email": data.Email.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// withGroupSettingsDefaults returns a copy of g with the settings it leaves
+// empty set to their value in defaultGroupSettings.
+func withGroupSettingsDefaults(g *groupssettings.Groups) *groupssettings.Groups {
+	merged := *g
+
+	v := reflect.ValueOf(&merged).Elem()
+	defaults := reflect.ValueOf(defaultGroupSettings)
+	for i := range v.NumField() {
+		f := v.Field(i)
+		if f.Kind() == reflect.String && f.String() == "" {
+			f.SetString(defaults.Field(i).String())
+		}
+	}
+
+	return &merged
+}
+
+// flattenGroupSettingsDataSource stores g in data, decoding the
+// string-encoded booleans of the Groups Settings API.
+func flattenGroupSettingsDataSource(g *groupssettings.Groups, data *GroupSettingsDataSourceModel) {
+	data.Name = types.StringValue(g.Name)
+	data.Description = types.StringValue(g.Description)
+	data.WhoCanJoin = types.StringValue(g.WhoCanJoin)
+	data.WhoCanViewMembership = types.StringValue(g.WhoCanViewMembership)
+	data.WhoCanViewGroup = types.StringValue(g.WhoCanViewGroup)
+	data.WhoCanPostMessage = types.StringValue(g.WhoCanPostMessage)
+	data.WhoCanContactOwner = types.StringValue(g.WhoCanContactOwner)
+	data.WhoCanDiscoverGroup = types.StringValue(g.WhoCanDiscoverGroup)
+	data.WhoCanLeaveGroup = types.StringValue(g.WhoCanLeaveGroup)
+	data.WhoCanModerateMembers = types.StringValue(g.WhoCanModerateMembers)
+	data.WhoCanModerateContent = types.StringValue(g.WhoCanModerateContent)
+	data.WhoCanAssistContent = types.StringValue(g.WhoCanAssistContent)
+	data.MessageModerationLevel = types.StringValue(g.MessageModerationLevel)
+	data.SpamModerationLevel = types.StringValue(g.SpamModerationLevel)
+	data.ReplyTo = types.StringValue(g.ReplyTo)
+	data.CustomReplyTo = types.StringValue(g.CustomReplyTo)
+	data.DefaultSender = types.StringValue(g.DefaultSender)
+	data.CustomFooterText = types.StringValue(g.CustomFooterText)
+	data.DefaultMessageDenyNotificationText = types.StringValue(g.DefaultMessageDenyNotificationText)
+	data.PrimaryLanguage = types.StringValue(g.PrimaryLanguage)
+	data.AllowExternalMembers = boolFromGroupSetting(g.AllowExternalMembers)
+	data.AllowWebPosting = boolFromGroupSetting(g.AllowWebPosting)
+	data.IsArchived = boolFromGroupSetting(g.IsArchived)
+	data.ArchiveOnly = boolFromGroupSetting(g.ArchiveOnly)
+	data.MembersCanPostAsTheGroup = boolFromGroupSetting(g.MembersCanPostAsTheGroup)
+	data.IncludeInGlobalAddressList = boolFromGroupSetting(g.IncludeInGlobalAddressList)
+	data.IncludeCustomFooter = boolFromGroupSetting(g.IncludeCustomFooter)
+	data.SendMessageDenyNotification = boolFromGroupSetting(g.SendMessageDenyNotification)
+	data.EnableCollaborativeInbox = boolFromGroupSetting(g.EnableCollaborativeInbox)
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/groupssettings/v1"
+)
+
+func TestWithGroupSettingsDefaults(t *testing.T) {
+	// A group with mostly default settings, Google leaves most of them empty.
+	g := &groupssettings.Groups{
+		Email:      "team@example.com",
+		Name:       "Team",
+		WhoCanJoin: "INVITED_CAN_JOIN",
+	}
+
+	merged := withGroupSettingsDefaults(g)
+
+	want := defaultGroupSettings
+	want.Email = "team@example.com"
+	want.Name = "Team"
+	want.WhoCanJoin = "INVITED_CAN_JOIN"
+	if !reflect.DeepEqual(*merged, want) {
+		t.Errorf("got %+v, want %+v", *merged, want)
+	}
+
+	if g.WhoCanPostMessage != "" {
+		t.Errorf("got whoCanPostMessage %q in the original settings, want them left untouched", g.WhoCanPostMessage)
+	}
+}
+
+func TestFlattenGroupSettingsDataSourceMergeWithDefaults(t *testing.T) {
+	g := &groupssettings.Groups{
+		Email:           "team@example.com",
+		AllowWebPosting: "false",
+	}
+
+	tests := map[string]struct {
+		settings              *groupssettings.Groups
+		wantWhoCanPostMessage types.String
+		wantAllowWebPosting   types.Bool
+		wantSpamModeration    types.String
+	}{
+		"as returned": {
+			settings:              g,
+			wantWhoCanPostMessage: types.StringValue(""),
+			wantAllowWebPosting:   types.BoolValue(false),
+			wantSpamModeration:    types.StringValue(""),
+		},
+		"merged with defaults": {
+			settings:              withGroupSettingsDefaults(g),
+			wantWhoCanPostMessage: types.StringValue(defaultGroupSettings.WhoCanPostMessage),
+			wantAllowWebPosting:   types.BoolValue(false),
+			wantSpamModeration:    types.StringValue(defaultGroupSettings.SpamModerationLevel),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var data GroupSettingsDataSourceModel
+			flattenGroupSettingsDataSource(test.settings, &data)
+
+			if !data.WhoCanPostMessage.Equal(test.wantWhoCanPostMessage) {
+				t.Errorf("got who_can_post_message %s, want %s", data.WhoCanPostMessage, test.wantWhoCanPostMessage)
+			}
+			if !data.AllowWebPosting.Equal(test.wantAllowWebPosting) {
+				t.Errorf("got allow_web_posting %s, want %s", data.AllowWebPosting, test.wantAllowWebPosting)
+			}
+			if !data.SpamModerationLevel.Equal(test.wantSpamModeration) {
+				t.Errorf("got spam_moderation_level %s, want %s", data.SpamModerationLevel, test.wantSpamModeration)
+			}
+		})
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/groupssettings/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupSettingsResource{}
+var _ resource.ResourceWithImportState = &GroupSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &GroupSettingsResource{}
+
+// defaultGroupSettings holds the settings Google applies to a newly created
+// group. They are used as the schema defaults and restored on Delete.
+var defaultGroupSettings = groupssettings.Groups{
+	WhoCanPostMessage:      "ANYONE_CAN_POST",
+	WhoCanJoin:             "CAN_REQUEST_TO_JOIN",
+	WhoCanApproveMembers:   "ALL_MANAGERS_CAN_APPROVE",
+	AllowExternalMembers:   "false",
+	AllowWebPosting:        "true",
+	IsArchived:             "false",
+	MessageModerationLevel: "MODERATE_NONE",
+	SpamModerationLevel:    "MODERATE",
+}
+
+func NewGroupSettingsResource() resource.Resource {
+	return &GroupSettingsResource{}
+}
+
+// GroupSettingsResource defines the resource implementation.
+type GroupSettingsResource struct {
+	client *http.Client
+
+	groupssettingsService *groupssettings.Service
+}
+
+// GroupSettingsResourceModel describes the resource data model.
+type GroupSettingsResourceModel struct {
+	Email                  types.String `tfsdk:"email"`
+	WhoCanPostMessage      types.String `tfsdk:"who_can_post_message"`
+	WhoCanJoin             types.String `tfsdk:"who_can_join"`
+	WhoCanApproveMembers   types.String `tfsdk:"who_can_approve_members"`
+	AllowExternalMembers   types.Bool   `tfsdk:"allow_external_members"`
+	AllowWebPosting        types.Bool   `tfsdk:"allow_web_posting"`
+	IsArchived             types.Bool   `tfsdk:"is_archived"`
+	MessageModerationLevel types.String `tfsdk:"message_moderation_level"`
+	SpamModerationLevel    types.String `tfsdk:"spam_moderation_level"`
+	Id                     types.String `tfsdk:"id"`
+}
+
+func (r *GroupSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_settings"
+}
+
+func (r *GroupSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Group settings resource. Settings always exist once a group exists, so
+		creating this resource updates them and destroying it restores Google's defaults.
+		Every setting defaults to Google's default value: removing a setting from the
+		configuration resets it, and changes made outside of Terraform show up as drift.
+		Import the settings of an existing group by its email address.` + scopesDescription(groupssettings.AppsGroupsSettingsScope),
+
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"who_can_post_message": schema.StringAttribute{
+				MarkdownDescription: `Permissions to post messages. One of NONE_CAN_POST,
+				ALL_MANAGERS_CAN_POST, ALL_MEMBERS_CAN_POST, ALL_OWNERS_CAN_POST,
+				ALL_IN_DOMAIN_CAN_POST or ANYONE_CAN_POST.`,
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultGroupSettings.WhoCanPostMessage),
+				Validators: []validator.String{
+					stringOneOf("NONE_CAN_POST", "ALL_MANAGERS_CAN_POST", "ALL_MEMBERS_CAN_POST",
+						"ALL_OWNERS_CAN_POST", "ALL_IN_DOMAIN_CAN_POST", "ANYONE_CAN_POST"),
+				},
+			},
+			"who_can_join": schema.StringAttribute{
+				MarkdownDescription: `Permission to join the group. One of ANYONE_CAN_JOIN,
+				ALL_IN_DOMAIN_CAN_JOIN, INVITED_CAN_JOIN or CAN_REQUEST_TO_JOIN.`,
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultGroupSettings.WhoCanJoin),
+				Validators: []validator.String{
+					stringOneOf("ANYONE_CAN_JOIN", "ALL_IN_DOMAIN_CAN_JOIN", "INVITED_CAN_JOIN", "CAN_REQUEST_TO_JOIN"),
+				},
+			},
+			"who_can_approve_members": schema.StringAttribute{
+				MarkdownDescription: `Who can approve requests to join the group. One of ALL_MEMBERS_CAN_APPROVE,
+				ALL_MANAGERS_CAN_APPROVE, ALL_OWNERS_CAN_APPROVE or NONE_CAN_APPROVE. Only applies
+				when who_can_join is CAN_REQUEST_TO_JOIN.`,
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultGroupSettings.WhoCanApproveMembers),
+				Validators: []validator.String{
+					stringOneOf("ALL_MEMBERS_CAN_APPROVE", "ALL_MANAGERS_CAN_APPROVE", "ALL_OWNERS_CAN_APPROVE", "NONE_CAN_APPROVE"),
+				},
+			},
+			"allow_external_members": schema.BoolAttribute{
+				MarkdownDescription: "Whether members external to the organization can join the group",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_web_posting": schema.BoolAttribute{
+				MarkdownDescription: "Whether members can post to the group from the web, e.g. for forum-style groups. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"is_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the contents of the group are archived",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"message_moderation_level": schema.StringAttribute{
+				MarkdownDescription: `Moderation level of incoming messages. One of MODERATE_ALL_MESSAGES,
+				MODERATE_NON_MEMBERS, MODERATE_NEW_MEMBERS or MODERATE_NONE.`,
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultGroupSettings.MessageModerationLevel),
+				Validators: []validator.String{
+					stringOneOf("MODERATE_ALL_MESSAGES", "MODERATE_NON_MEMBERS", "MODERATE_NEW_MEMBERS", "MODERATE_NONE"),
+				},
+			},
+			"spam_moderation_level": schema.StringAttribute{
+				MarkdownDescription: "How messages suspected to be spam are handled. One of ALLOW, MODERATE, SILENTLY_MODERATE or REJECT.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultGroupSettings.SpamModerationLevel),
+				Validators: []validator.String{
+					stringOneOf("ALLOW", "MODERATE", "SILENTLY_MODERATE", "REJECT"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Email address of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects who_can_approve_members unless users can request to
+// join the group, there are no requests to approve otherwise.
+func (r *GroupSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GroupSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WhoCanApproveMembers.IsNull() || data.WhoCanJoin.IsNull() || data.WhoCanJoin.IsUnknown() {
+		return
+	}
+
+	if data.WhoCanJoin.ValueString() != "CAN_REQUEST_TO_JOIN" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("who_can_approve_members"),
+			"Invalid Group Settings",
+			fmt.Sprintf("who_can_approve_members requires who_can_join to be CAN_REQUEST_TO_JOIN, got: %s", data.WhoCanJoin.ValueString()),
+		)
+	}
+}
+
+func (r *GroupSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.groupssettingsService = pd.groupssettingsService
+}
+
+func (r *GroupSettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data GroupSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings exist as soon as the group exists, so creating them is an update.
+	res, err := r.groupssettingsService.Groups.Update(data.Email.ValueString(), expandGroupSettings(&data)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Google Group Settings",
+			fmt.Sprintf("Could not update settings of group %s: %v", data.Email.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGroupSettings(res, &data)
+	data.Id = data.Email
+
+	tflog.Trace(ctx, "Created Google Group Settings", map[string]interface{}{
+		"email": data.Email.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupSettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data GroupSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.groupssettingsService.Groups.Get(data.Email.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Group no longer exists in Google Workspace, removing settings from state", map[string]interface{}{
+				"email": data.Email.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read settings of group '%s', got error: %s", data.Email.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGroupSettings(res, &data)
+	data.Id = data.Email
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupSettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data GroupSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.groupssettingsService.Groups.Update(data.Email.ValueString(), expandGroupSettings(&data)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Google Group Settings",
+			fmt.Sprintf("Could not update settings of group %s: %v", data.Email.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenGroupSettings(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupSettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data GroupSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted, restore the defaults instead.
+	defaults := defaultGroupSettings
+	_, err := r.groupssettingsService.Groups.Update(data.Email.ValueString(), &defaults).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// The group itself is gone, and its settings with it.
+			tflog.Warn(ctx, "Group already deleted in Google Workspace", map[string]interface{}{
+				"email": data.Email.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Google Group Settings",
+			fmt.Sprintf("Could not restore default settings of group %s: %v", data.Email.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *GroupSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Every group has settings, Read fills in the current ones.
+	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
+}
+
+func expandGroupSettings(data *GroupSettingsResourceModel) *groupssettings.Groups {
+	return &groupssettings.Groups{
+		WhoCanPostMessage:      data.WhoCanPostMessage.ValueString(),
+		WhoCanJoin:             data.WhoCanJoin.ValueString(),
+		WhoCanApproveMembers:   data.WhoCanApproveMembers.ValueString(),
+		AllowExternalMembers:   boolToGroupSetting(data.AllowExternalMembers),
+		AllowWebPosting:        boolToGroupSetting(data.AllowWebPosting),
+		IsArchived:             boolToGroupSetting(data.IsArchived),
+		MessageModerationLevel: data.MessageModerationLevel.ValueString(),
+		SpamModerationLevel:    data.SpamModerationLevel.ValueString(),
+	}
+}
+
+func flattenGroupSettings(g *groupssettings.Groups, data *GroupSettingsResourceModel) {
+	data.WhoCanPostMessage = types.StringValue(g.WhoCanPostMessage)
+	data.WhoCanJoin = types.StringValue(g.WhoCanJoin)
+	data.WhoCanApproveMembers = types.StringValue(g.WhoCanApproveMembers)
+	data.AllowExternalMembers = boolFromGroupSetting(g.AllowExternalMembers)
+	// An empty value means Google applies its default, store that rather than
+	// false so it doesn't show up as drift.
+	data.AllowWebPosting = boolFromGroupSetting(cmp.Or(g.AllowWebPosting, defaultGroupSettings.AllowWebPosting))
+	data.IsArchived = boolFromGroupSetting(g.IsArchived)
+	data.MessageModerationLevel = types.StringValue(g.MessageModerationLevel)
+	data.SpamModerationLevel = types.StringValue(g.SpamModerationLevel)
+}
+
+// boolToGroupSetting encodes a boolean the way the Groups Settings API
+// expects it, as the string "true" or "false".
+func boolToGroupSetting(b types.Bool) string {
+	return strconv.FormatBool(b.ValueBool())
+}
+
+// boolFromGroupSetting decodes a string-encoded Groups Settings boolean. An
+// empty or otherwise unparsable value is treated as false.
+func boolFromGroupSetting(s string) types.Bool {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return types.BoolValue(false)
+	}
+	return types.BoolValue(b)
+}
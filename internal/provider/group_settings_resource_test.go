@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testGroupSettingsModel returns the model of a group with the default
+// settings.
+func testGroupSettingsModel() GroupSettingsResourceModel {
+	data := GroupSettingsResourceModel{
+		Email: types.StringValue("team@example.com"),
+		Id:    types.StringValue("team@example.com"),
+	}
+	flattenGroupSettings(&defaultGroupSettings, &data)
+
+	return data
+}
+
+func TestGroupSettingsResourceValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		whoCanJoin           types.String
+		whoCanApproveMembers types.String
+		wantErr              bool
+	}{
+		"defaults": {
+			whoCanJoin:           types.StringNull(),
+			whoCanApproveMembers: types.StringNull(),
+		},
+		"approvers with the default who_can_join": {
+			whoCanJoin:           types.StringNull(),
+			whoCanApproveMembers: types.StringValue("ALL_OWNERS_CAN_APPROVE"),
+		},
+		"approvers with join requests": {
+			whoCanJoin:           types.StringValue("CAN_REQUEST_TO_JOIN"),
+			whoCanApproveMembers: types.StringValue("ALL_MEMBERS_CAN_APPROVE"),
+		},
+		"anyone can join without approvers": {
+			whoCanJoin:           types.StringValue("ANYONE_CAN_JOIN"),
+			whoCanApproveMembers: types.StringNull(),
+		},
+		"approvers without join requests": {
+			whoCanJoin:           types.StringValue("INVITED_CAN_JOIN"),
+			whoCanApproveMembers: types.StringValue("ALL_MANAGERS_CAN_APPROVE"),
+			wantErr:              true,
+		},
+		"unknown who_can_join": {
+			whoCanJoin:           types.StringUnknown(),
+			whoCanApproveMembers: types.StringValue("ALL_MANAGERS_CAN_APPROVE"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &GroupSettingsResource{}
+
+			data := testGroupSettingsModel()
+			data.WhoCanJoin = test.whoCanJoin
+			data.WhoCanApproveMembers = test.whoCanApproveMembers
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newTestResourceConfig(t, r, &data)}, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Errorf("got diagnostics %v, want an error: %t", resp.Diagnostics, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestGroupSettingsWhoCanApproveMembers(t *testing.T) {
+	data := testGroupSettingsModel()
+	data.WhoCanApproveMembers = types.StringValue("ALL_OWNERS_CAN_APPROVE")
+
+	g := expandGroupSettings(&data)
+	if g.WhoCanApproveMembers != "ALL_OWNERS_CAN_APPROVE" {
+		t.Fatalf("got whoCanApproveMembers %q, want %q", g.WhoCanApproveMembers, "ALL_OWNERS_CAN_APPROVE")
+	}
+
+	refreshed := testGroupSettingsModel()
+	flattenGroupSettings(g, &refreshed)
+	if refreshed != data {
+		t.Errorf("got %+v after a refresh, want %+v", refreshed, data)
+	}
+}
+
+func TestGroupSettingsAllowWebPosting(t *testing.T) {
+	tests := map[string]struct {
+		allowWebPosting types.Bool
+		wantSetting     string
+	}{
+		"enabled": {
+			allowWebPosting: types.BoolValue(true),
+			wantSetting:     "true",
+		},
+		"disabled": {
+			allowWebPosting: types.BoolValue(false),
+			wantSetting:     "false",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := testGroupSettingsModel()
+			data.AllowWebPosting = test.allowWebPosting
+
+			g := expandGroupSettings(&data)
+			if g.AllowWebPosting != test.wantSetting {
+				t.Fatalf("got allowWebPosting %q, want %q", g.AllowWebPosting, test.wantSetting)
+			}
+
+			refreshed := testGroupSettingsModel()
+			flattenGroupSettings(g, &refreshed)
+			if !refreshed.AllowWebPosting.Equal(test.allowWebPosting) {
+				t.Errorf("got allow_web_posting %s after a refresh, want %s", refreshed.AllowWebPosting, test.allowWebPosting)
+			}
+		})
+	}
+}
+
+func TestFlattenGroupSettingsAllowWebPosting(t *testing.T) {
+	tests := map[string]struct {
+		setting string
+		want    types.Bool
+	}{
+		"true":                   {setting: "true", want: types.BoolValue(true)},
+		"false":                  {setting: "false", want: types.BoolValue(false)},
+		"upper case":             {setting: "FALSE", want: types.BoolValue(false)},
+		"empty is the default":   {setting: "", want: types.BoolValue(true)},
+		"invalid value is false": {setting: "maybe", want: types.BoolValue(false)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := defaultGroupSettings
+			g.AllowWebPosting = test.setting
+
+			var data GroupSettingsResourceModel
+			flattenGroupSettings(&g, &data)
+
+			if !data.AllowWebPosting.Equal(test.want) {
+				t.Errorf("got %s, want %s", data.AllowWebPosting, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupsDataSource{}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+// GroupsDataSource defines the data source implementation.
+type GroupsDataSource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// GroupsDataSourceModel describes the data source data model.
+type GroupsDataSourceModel struct {
+	Customer   types.String            `tfsdk:"customer"`
+	Domain     types.String            `tfsdk:"domain"`
+	Query      types.String            `tfsdk:"query"`
+	MaxResults types.Int64             `tfsdk:"max_results"`
+	Groups     []GroupsDataSourceGroup `tfsdk:"groups"`
+	TotalCount types.Int64             `tfsdk:"total_count"`
+	Id         types.String            `tfsdk:"id"`
+}
+
+// Nested Model for "groups".
+type GroupsDataSourceGroup struct {
+	Id                 types.String `tfsdk:"id"`
+	Email              types.String `tfsdk:"email"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	DirectMembersCount types.Int64  `tfsdk:"direct_members_count"`
+}
+
+func (d *GroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the groups of a customer or domain, following every page of results",
+
+		Attributes: map[string]schema.Attribute{
+			"customer": schema.StringAttribute{
+				MarkdownDescription: `The unique ID for the customer's Google Workspace account. Defaults to
+				the provider customer_id. Ignored when domain is set.`,
+				Optional: true,
+				Computed: true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Only list the groups of this domain",
+				Optional:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: `Directory search query, see
+				https://developers.google.com/admin-sdk/directory/v1/guides/search-groups`,
+				Optional: true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "Stop listing once this many groups have been returned",
+				Optional:            true,
+			},
+			"groups": schema.ListNestedAttribute{
+				MarkdownDescription: "The groups matching the filters, sorted by email",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Group identifier",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The group's email address",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The group's display name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The group's description",
+							Computed:            true,
+						},
+						"direct_members_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of users that are direct members of the group",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of groups returned",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.adminService = pd.adminService
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer := d.customerId
+	if !data.Customer.IsNull() && !data.Customer.IsUnknown() {
+		customer = data.Customer.ValueString()
+	}
+
+	// The API accepts either a customer or a domain, not both.
+	call := d.adminService.Groups.List().MaxResults(200)
+	scope := customer
+	if domain := data.Domain.ValueString(); domain != "" {
+		call = call.Domain(domain)
+		scope = domain
+	} else {
+		call = call.Customer(customer)
+	}
+	if query := data.Query.ValueString(); query != "" {
+		call = call.Query(query)
+	}
+
+	maxResults := int(data.MaxResults.ValueInt64())
+
+	data.Groups = []GroupsDataSourceGroup{}
+
+	pageToken := ""
+	for {
+		page, err := call.PageToken(pageToken).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list groups for '%s', got error: %s", scope, formatAPIError(err)),
+			)
+			return
+		}
+
+		for _, g := range page.Groups {
+			if maxResults > 0 && len(data.Groups) >= maxResults {
+				break
+			}
+			data.Groups = append(data.Groups, GroupsDataSourceGroup{
+				Id:                 types.StringValue(g.Id),
+				Email:              types.StringValue(g.Email),
+				Name:               types.StringValue(g.Name),
+				Description:        types.StringValue(g.Description),
+				DirectMembersCount: types.Int64Value(g.DirectMembersCount),
+			})
+		}
+
+		pageToken = page.NextPageToken
+		if pageToken == "" || (maxResults > 0 && len(data.Groups) >= maxResults) {
+			break
+		}
+	}
+
+	data.Customer = types.StringValue(customer)
+	data.TotalCount = types.Int64Value(int64(len(data.Groups)))
+	sortByKey(data.Groups, func(g GroupsDataSourceGroup) string { return g.Email.ValueString() })
+
+	data.Id = types.StringValue(scope)
+
+	tflog.Trace(ctx, "read groups", map[string]interface{}{
+		"scope": scope,
+		"count": len(data.Groups),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &InvalidateVerificationCodesAction{}
+var _ action.ActionWithConfigure = &InvalidateVerificationCodesAction{}
+
+func NewInvalidateVerificationCodesAction() action.Action {
+	return &InvalidateVerificationCodesAction{}
+}
+
+// InvalidateVerificationCodesAction defines the action implementation.
+type InvalidateVerificationCodesAction struct {
+	client *http.Client
+
+	adminService *admin.Service
+}
+
+// InvalidateVerificationCodesActionModel describes the action data model.
+type InvalidateVerificationCodesActionModel struct {
+	UserKey types.String `tfsdk:"user_key"`
+}
+
+func (a *InvalidateVerificationCodesAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invalidate_verification_codes"
+}
+
+func (a *InvalidateVerificationCodesAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Invalidates the backup verification codes of a user, e.g. once an
+		account has been recovered with codes from googleworkspace_verification_codes.` + scopesDescription(admin.AdminDirectoryUserSecurityScope),
+
+		Attributes: map[string]schema.Attribute{
+			"user_key": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address, alias email address, or unique user ID",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *InvalidateVerificationCodesAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = pd.client
+	a.adminService = pd.adminService
+}
+
+func (a *InvalidateVerificationCodesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data InvalidateVerificationCodesActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userKey := data.UserKey.ValueString()
+
+	err := a.adminService.VerificationCodes.Invalidate(userKey).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"User Not Found",
+				fmt.Sprintf("User %s does not exist in Google Workspace.", userKey),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Invalidating Verification Codes",
+			fmt.Sprintf("Could not invalidate verification codes of user %s: %v", userKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Invalidated verification codes", map[string]interface{}{
+		"user_key": userKey,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Invalidated the verification codes of user %s", userKey),
+	})
+}
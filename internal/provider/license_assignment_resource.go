@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/licensing/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LicenseAssignmentResource{}
+var _ resource.ResourceWithImportState = &LicenseAssignmentResource{}
+
+func NewLicenseAssignmentResource() resource.Resource {
+	return &LicenseAssignmentResource{}
+}
+
+// LicenseAssignmentResource defines the resource implementation.
+type LicenseAssignmentResource struct {
+	client *http.Client
+
+	licensingService *licensing.Service
+}
+
+// LicenseAssignmentResourceModel describes the resource data model.
+type LicenseAssignmentResourceModel struct {
+	ProductId   types.String `tfsdk:"product_id"`
+	SkuId       types.String `tfsdk:"sku_id"`
+	UserId      types.String `tfsdk:"user_id"`
+	ProductName types.String `tfsdk:"product_name"`
+	SkuName     types.String `tfsdk:"sku_name"`
+	Etags       types.String `tfsdk:"etags"`
+	Id          types.String `tfsdk:"id"`
+}
+
+func (r *LicenseAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license_assignment"
+}
+
+func (r *LicenseAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `License of a product SKU assigned to a user. Changing the SKU moves the
+		license to the new SKU in place, destroying the resource leaves the user without a
+		license of the product.` + scopesDescription(licensing.AppsLicensingScope),
+
+		Attributes: map[string]schema.Attribute{
+			"product_id": schema.StringAttribute{
+				MarkdownDescription: "The product's ID, e.g. 'Google-Apps' for Google Workspace",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sku_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the product SKU, e.g. '1010020027' for Business Starter",
+				Required:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Primary email address of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the product",
+				Computed:            true,
+			},
+			"sku_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the product SKU",
+				Computed:            true,
+			},
+			"etags": schema.StringAttribute{
+				MarkdownDescription: "ETag of the license assignment",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, in the format product_id/sku_id/user_id",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *LicenseAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.licensingService = pd.licensingService
+}
+
+func (r *LicenseAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LicenseAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	la, err := r.licensingService.LicenseAssignments.Insert(data.ProductId.ValueString(), data.SkuId.ValueString(), &licensing.LicenseAssignmentInsert{
+		UserId: data.UserId.ValueString(),
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating License Assignment",
+			fmt.Sprintf("Could not assign a license of SKU %s to user %s: %v", data.SkuId.ValueString(), data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenLicenseAssignment(la, &data)
+
+	tflog.Trace(ctx, "Created License Assignment", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LicenseAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LicenseAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	la, err := r.licensingService.LicenseAssignments.Get(data.ProductId.ValueString(), data.SkuId.ValueString(), data.UserId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "License Assignment no longer exists in Google Workspace, removing from state", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read license assignment '%s', got error: %s", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenLicenseAssignment(la, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update moves the license to another SKU of the same product, the SKU is
+// the only attribute that can change in place.
+func (r *LicenseAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state LicenseAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	la, err := r.licensingService.LicenseAssignments.Patch(state.ProductId.ValueString(), state.SkuId.ValueString(), state.UserId.ValueString(), &licensing.LicenseAssignment{
+		SkuId: data.SkuId.ValueString(),
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating License Assignment",
+			fmt.Sprintf("Could not move the license of user %s from SKU %s to %s: %v", state.UserId.ValueString(), state.SkuId.ValueString(), data.SkuId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenLicenseAssignment(la, &data)
+
+	tflog.Trace(ctx, "Updated License Assignment", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LicenseAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LicenseAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.licensingService.LicenseAssignments.Delete(data.ProductId.ValueString(), data.SkuId.ValueString(), data.UserId.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "License Assignment already deleted in Google Workspace", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting License Assignment",
+			fmt.Sprintf("Could not remove the license of SKU %s from user %s: %v", data.SkuId.ValueString(), data.UserId.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *LicenseAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier in the format product_id/sku_id/user_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("product_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sku_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// flattenLicenseAssignment stores la in data. The user ID is kept as
+// configured as long as it names the same user, Google may return it in
+// different casing.
+func flattenLicenseAssignment(la *licensing.LicenseAssignment, data *LicenseAssignmentResourceModel) {
+	if !strings.EqualFold(data.UserId.ValueString(), la.UserId) {
+		data.UserId = types.StringValue(la.UserId)
+	}
+
+	data.ProductId = types.StringValue(la.ProductId)
+	data.SkuId = types.StringValue(la.SkuId)
+	data.ProductName = types.StringValue(la.ProductName)
+	data.SkuName = types.StringValue(la.SkuName)
+	data.Etags = types.StringValue(la.Etags)
+	data.Id = types.StringValue(la.ProductId + "/" + la.SkuId + "/" + data.UserId.ValueString())
+}
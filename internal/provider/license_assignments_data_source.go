@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/licensing/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LicenseAssignmentsDataSource{}
+
+func NewLicenseAssignmentsDataSource() datasource.DataSource {
+	return &LicenseAssignmentsDataSource{}
+}
+
+// LicenseAssignmentsDataSource defines the data source implementation.
+type LicenseAssignmentsDataSource struct {
+	client     *http.Client
+	customerId string
+
+	adminService     *admin.Service
+	licensingService *licensing.Service
+}
+
+// LicenseAssignmentsDataSourceModel describes the data source data model.
+type LicenseAssignmentsDataSourceModel struct {
+	ProductId   types.String                             `tfsdk:"product_id"`
+	CustomerId  types.String                             `tfsdk:"customer_id"`
+	Assignments []LicenseAssignmentsDataSourceAssignment `tfsdk:"assignments"`
+	Id          types.String                             `tfsdk:"id"`
+}
+
+// Nested Model for "assignments".
+type LicenseAssignmentsDataSourceAssignment struct {
+	UserId    types.String `tfsdk:"user_id"`
+	SkuId     types.String `tfsdk:"sku_id"`
+	ProductId types.String `tfsdk:"product_id"`
+}
+
+func (d *LicenseAssignmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license_assignments"
+}
+
+func (d *LicenseAssignmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the licenses of a product that are assigned to users of the customer" + scopesDescription(licensing.AppsLicensingScope, admin.AdminDirectoryCustomerReadonlyScope),
+
+		Attributes: map[string]schema.Attribute{
+			"product_id": schema.StringAttribute{
+				MarkdownDescription: "The product's ID, e.g. 'Google-Apps' for Google Workspace",
+				Required:            true,
+			},
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: `The customer's unique ID or primary domain. Defaults to the provider
+				customer_id.`,
+				Optional: true,
+				Computed: true,
+			},
+			"assignments": schema.ListNestedAttribute{
+				MarkdownDescription: "The license assignments sorted by user_id and sku_id, empty when no licenses of the product are assigned",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "Primary email address of the user the license is assigned to",
+							Computed:            true,
+						},
+						"sku_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the product SKU the license is for",
+							Computed:            true,
+						},
+						"product_id": schema.StringAttribute{
+							MarkdownDescription: "The product's ID",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier, in the format customer_id/product_id",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *LicenseAssignmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.adminService = pd.adminService
+	d.licensingService = pd.licensingService
+}
+
+func (d *LicenseAssignmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LicenseAssignmentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer := d.customerId
+	if !data.CustomerId.IsNull() && !data.CustomerId.IsUnknown() {
+		customer = data.CustomerId.ValueString()
+	}
+
+	// The Enterprise License Manager API doesn't know the my_customer
+	// alias, look up the ID it stands for.
+	if customer == defaultCustomerId {
+		c, err := d.adminService.Customers.Get(customer).Fields("id").Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read customer '%s', got error: %s", customer, formatAPIError(err)),
+			)
+			return
+		}
+		customer = c.Id
+	}
+
+	productId := data.ProductId.ValueString()
+
+	data.Assignments = []LicenseAssignmentsDataSourceAssignment{}
+
+	err := d.licensingService.LicenseAssignments.ListForProduct(productId, customer).MaxResults(1000).Pages(ctx, func(page *licensing.LicenseAssignmentList) error {
+		for _, a := range page.Items {
+			data.Assignments = append(data.Assignments, LicenseAssignmentsDataSourceAssignment{
+				UserId:    types.StringValue(a.UserId),
+				SkuId:     types.StringValue(a.SkuId),
+				ProductId: types.StringValue(a.ProductId),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list license assignments of product '%s' for '%s', got error: %s", productId, customer, formatAPIError(err)),
+		)
+		return
+	}
+
+	sortByKey(data.Assignments, func(a LicenseAssignmentsDataSourceAssignment) string {
+		return a.UserId.ValueString() + "/" + a.SkuId.ValueString()
+	})
+
+	data.CustomerId = types.StringValue(customer)
+	data.Id = types.StringValue(customer + "/" + productId)
+
+	tflog.Trace(ctx, "read license assignments", map[string]interface{}{
+		"customer_id": customer,
+		"product_id":  productId,
+		"count":       len(data.Assignments),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
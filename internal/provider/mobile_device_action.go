@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &MobileDeviceAction{}
+var _ action.ActionWithConfigure = &MobileDeviceAction{}
+var _ action.ActionWithValidateConfig = &MobileDeviceAction{}
+
+func NewMobileDeviceAction() action.Action {
+	return &MobileDeviceAction{}
+}
+
+// MobileDeviceAction defines the action implementation.
+type MobileDeviceAction struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// MobileDeviceActionModel describes the action data model.
+type MobileDeviceActionModel struct {
+	ResourceId types.String `tfsdk:"resource_id"`
+	Action     types.String `tfsdk:"action"`
+	Confirm    types.Bool   `tfsdk:"confirm"`
+}
+
+// mobileDeviceWipeActions erase data from the device and cannot be undone.
+var mobileDeviceWipeActions = map[string]bool{
+	"admin_account_wipe": true,
+	"admin_remote_wipe":  true,
+}
+
+func (a *MobileDeviceAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mobile_device_action"
+}
+
+func (a *MobileDeviceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Performs an action on a mobile device, such as wiping or blocking a
+		lost phone. The action is sent every time it is invoked.` + scopesDescription(admin.AdminDirectoryDeviceMobileActionScope),
+
+		Attributes: map[string]schema.Attribute{
+			"resource_id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID the API service uses to identify the mobile device",
+				Required:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: `The action to perform: admin_account_wipe, admin_remote_wipe,
+				approve, block or cancel_remote_wipe_then_activate.`,
+				Required: true,
+				Validators: []validator.String{
+					stringOneOf("admin_account_wipe", "admin_remote_wipe", "approve", "block", "cancel_remote_wipe_then_activate"),
+				},
+			},
+			"confirm": schema.BoolAttribute{
+				MarkdownDescription: "Must be true for admin_account_wipe and admin_remote_wipe, which cannot be undone",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *MobileDeviceAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data MobileDeviceActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Action.IsUnknown() || data.Confirm.IsUnknown() {
+		return
+	}
+
+	if mobileDeviceWipeActions[data.Action.ValueString()] && !data.Confirm.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("confirm"),
+			"Wipe Not Confirmed",
+			fmt.Sprintf("%s erases data from the device and cannot be undone, set confirm to true to perform it.", data.Action.ValueString()),
+		)
+	}
+}
+
+func (a *MobileDeviceAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = pd.client
+	a.customerId = pd.customerId
+	a.adminService = pd.adminService
+}
+
+func (a *MobileDeviceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data MobileDeviceActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceId := data.ResourceId.ValueString()
+	deviceAction := data.Action.ValueString()
+
+	err := a.adminService.Mobiledevices.Action(a.customerId, resourceId, &admin.MobileDeviceAction{
+		Action: deviceAction,
+	}).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("resource_id"),
+				"Mobile Device Not Found",
+				fmt.Sprintf("Mobile device %s does not exist in Google Workspace.", resourceId),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Performing Mobile Device Action",
+			fmt.Sprintf("Could not %s mobile device %s: %v", deviceAction, resourceId, formatAPIError(err)),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Performed mobile device action", map[string]interface{}{
+		"resource_id": resourceId,
+		"action":      deviceAction,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Performed %s on mobile device %s", deviceAction, resourceId),
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeEmailFunction{}
+
+func NewNormalizeEmailFunction() function.Function {
+	return &NormalizeEmailFunction{}
+}
+
+// NormalizeEmailFunction defines the function implementation.
+type NormalizeEmailFunction struct{}
+
+// normalizeEmail lowercases and trims an email address and validates it as a
+// bare RFC 5322 address, i.e. without a display name or angle brackets, with
+// a domain that contains at least one dot.
+func normalizeEmail(email string) (string, error) {
+	normalized := canonicalKey(email)
+	if normalized == "" {
+		return "", fmt.Errorf("email address must not be empty")
+	}
+
+	addr, err := mail.ParseAddress(normalized)
+	if err != nil || addr.Name != "" || addr.Address != normalized {
+		return "", fmt.Errorf("%q is not a valid email address", email)
+	}
+
+	at := strings.LastIndex(normalized, "@")
+	domain := normalized[at+1:]
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return "", fmt.Errorf("%q does not have a valid domain", email)
+	}
+
+	return normalized, nil
+}
+
+func (f *NormalizeEmailFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_email"
+}
+
+func (f *NormalizeEmailFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize and validate an email address",
+		MarkdownDescription: `Lowercases and trims surrounding whitespace from an email address and
+		validates it against RFC 5322, failing for malformed addresses such as a
+		missing domain. Use it on user input to catch typos at plan time.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "email",
+				MarkdownDescription: "Email address to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeEmailFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var email string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &email))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeEmail(email)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeEmailFunction(t *testing.T) {
+	tests := map[string]struct {
+		email   string
+		want    string
+		wantErr *function.FuncError
+	}{
+		"already normalized": {
+			email: "jdoe@example.com",
+			want:  "jdoe@example.com",
+		},
+		"case folding": {
+			email: "JDoe@Example.COM",
+			want:  "jdoe@example.com",
+		},
+		"surrounding whitespace": {
+			email: "  jdoe@example.com\t",
+			want:  "jdoe@example.com",
+		},
+		"plus addressing and subdomain": {
+			email: "j.doe+tf@mail.example.co.uk",
+			want:  "j.doe+tf@mail.example.co.uk",
+		},
+		"empty": {
+			email:   "",
+			wantErr: function.NewArgumentFuncError(0, "email address must not be empty"),
+		},
+		"whitespace only": {
+			email:   "   ",
+			wantErr: function.NewArgumentFuncError(0, "email address must not be empty"),
+		},
+		"display name": {
+			email:   "John Doe <jdoe@example.com>",
+			wantErr: function.NewArgumentFuncError(0, `"John Doe <jdoe@example.com>" is not a valid email address`),
+		},
+		"angle brackets": {
+			email:   "<jdoe@example.com>",
+			wantErr: function.NewArgumentFuncError(0, `"<jdoe@example.com>" is not a valid email address`),
+		},
+		"missing at sign": {
+			email:   "jdoe.example.com",
+			wantErr: function.NewArgumentFuncError(0, `"jdoe.example.com" is not a valid email address`),
+		},
+		"domain without dot": {
+			email:   "jdoe@localhost",
+			wantErr: function.NewArgumentFuncError(0, `"jdoe@localhost" does not have a valid domain`),
+		},
+		"domain ending with dot": {
+			email:   "jdoe@example.",
+			wantErr: function.NewArgumentFuncError(0, `"jdoe@example." is not a valid email address`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runTestFunction(t, NewNormalizeEmailFunction(), types.StringValue(test.email))
+			if !err.Equal(test.wantErr) {
+				t.Fatalf("got error %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if want := types.StringValue(test.want); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
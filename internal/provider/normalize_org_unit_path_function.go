@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeOrgUnitPathFunction{}
+
+func NewNormalizeOrgUnitPathFunction() function.Function {
+	return &NormalizeOrgUnitPathFunction{}
+}
+
+// NormalizeOrgUnitPathFunction defines the function implementation.
+type NormalizeOrgUnitPathFunction struct{}
+
+// normalizeOrgUnitPath formats an org unit path the way Google returns it:
+// with a leading slash, without a trailing slash and without empty segments.
+// Whitespace around the path is trimmed, the case is left untouched since org
+// unit names are case sensitive.
+func normalizeOrgUnitPath(p string) (string, error) {
+	trimmed := strings.TrimSpace(p)
+	if trimmed == "" {
+		return "", fmt.Errorf("org unit path must not be empty, use \"/\" for the root org unit")
+	}
+
+	var segments []string
+	for _, s := range strings.Split(trimmed, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+
+	return "/" + strings.Join(segments, "/"), nil
+}
+
+func (f *NormalizeOrgUnitPathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_org_unit_path"
+}
+
+func (f *NormalizeOrgUnitPathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize an org unit path",
+		MarkdownDescription: `Ensures an org unit path has a leading slash, strips a trailing slash
+		and collapses duplicate slashes, e.g. 'normalize_org_unit_path("Engineering//Backend/")'
+		returns "/Engineering/Backend". The root org unit is "/". Fails for empty input.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "Org unit path to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeOrgUnitPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var p string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &p))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeOrgUnitPath(p)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
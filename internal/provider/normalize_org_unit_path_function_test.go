@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeOrgUnitPathFunction(t *testing.T) {
+	emptyErr := function.NewArgumentFuncError(0, `org unit path must not be empty, use "/" for the root org unit`)
+
+	tests := map[string]struct {
+		path    string
+		want    string
+		wantErr *function.FuncError
+	}{
+		"root": {
+			path: "/",
+			want: "/",
+		},
+		"already normalized": {
+			path: "/Sales/EMEA",
+			want: "/Sales/EMEA",
+		},
+		"missing leading slash": {
+			path: "Sales/EMEA",
+			want: "/Sales/EMEA",
+		},
+		"trailing slash": {
+			path: "/Sales/EMEA/",
+			want: "/Sales/EMEA",
+		},
+		"repeated slashes": {
+			path: "//Sales///EMEA",
+			want: "/Sales/EMEA",
+		},
+		"only slashes": {
+			path: "///",
+			want: "/",
+		},
+		"surrounding whitespace": {
+			path: "  /Sales/EMEA \n",
+			want: "/Sales/EMEA",
+		},
+		"case is preserved": {
+			path: "/sales/Emea",
+			want: "/sales/Emea",
+		},
+		"spaces in names are kept": {
+			path: "/Sales Team/North America",
+			want: "/Sales Team/North America",
+		},
+		"empty": {
+			path:    "",
+			wantErr: emptyErr,
+		},
+		"whitespace only": {
+			path:    " \t ",
+			wantErr: emptyErr,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runTestFunction(t, NewNormalizeOrgUnitPathFunction(), types.StringValue(test.path))
+			if !err.Equal(test.wantErr) {
+				t.Fatalf("got error %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if want := types.StringValue(test.want); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
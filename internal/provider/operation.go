@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cloudidentity "google.golang.org/api/cloudidentity/v1"
+)
+
+const (
+	operationPollInitialInterval = time.Second
+	operationPollMaxInterval     = 30 * time.Second
+)
+
+// operationError is the error of a long-running operation that finished
+// unsuccessfully.
+type operationError struct {
+	// Name identifies the operation, e.g. the operation name or the id of the
+	// object it works on.
+	Name    string
+	Code    int64
+	Message string
+}
+
+func (e *operationError) Error() string {
+	if e.Code == 0 {
+		return fmt.Sprintf("operation %s failed: %s", e.Name, e.Message)
+	}
+
+	return fmt.Sprintf("operation %s failed with code %d: %s", e.Name, e.Code, e.Message)
+}
+
+// waitForOperation calls poll until it reports the operation as done, poll
+// returns an error, or timeout has elapsed. Polls are spaced with a growing
+// interval. The last result of poll is returned along with the error, so the
+// caller can still store it.
+func waitForOperation[T any](ctx context.Context, timeout time.Duration, poll func(context.Context) (T, bool, error)) (T, error) {
+	deadline := time.Now().Add(timeout)
+	interval := operationPollInitialInterval
+
+	for {
+		res, done, err := poll(ctx)
+		if err != nil || done {
+			return res, err
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return res, fmt.Errorf("operation did not finish within %s", timeout)
+		}
+
+		tflog.Debug(ctx, "Operation not finished yet, polling", map[string]interface{}{
+			"interval": interval.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return res, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > operationPollMaxInterval {
+			interval = operationPollMaxInterval
+		}
+	}
+}
+
+// operationResponse decodes the response of a finished Cloud Identity
+// operation into v, which may be nil when the response isn't needed. It
+// reports whether the operation is done, and returns an *operationError if it
+// failed.
+func operationResponse(op *cloudidentity.Operation, v interface{}) (bool, error) {
+	if op == nil || !op.Done {
+		return false, nil
+	}
+
+	if op.Error != nil {
+		return true, &operationError{Name: op.Name, Code: op.Error.Code, Message: op.Error.Message}
+	}
+
+	if v != nil && len(op.Response) > 0 {
+		if err := json.Unmarshal(op.Response, v); err != nil {
+			return true, fmt.Errorf("unable to decode the response of operation %s: %w", op.Name, err)
+		}
+	}
+
+	return true, nil
+}
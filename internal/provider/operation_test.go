@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	cloudidentity "google.golang.org/api/cloudidentity/v1"
+)
+
+type testOperationResult struct {
+	Name string `json:"name"`
+}
+
+// fakeOperationPoller returns the operations in order, repeating the last
+// one, and decodes them with operationResponse like the real pollers do.
+type fakeOperationPoller struct {
+	operations []*cloudidentity.Operation
+	polls      int
+}
+
+func (f *fakeOperationPoller) poll(ctx context.Context) (testOperationResult, bool, error) {
+	op := f.operations[min(f.polls, len(f.operations)-1)]
+	f.polls++
+
+	var res testOperationResult
+	done, err := operationResponse(op, &res)
+	return res, done, err
+}
+
+func TestWaitForOperation(t *testing.T) {
+	pending := &cloudidentity.Operation{Name: "operations/1"}
+	succeeded := &cloudidentity.Operation{
+		Name:     "operations/1",
+		Done:     true,
+		Response: []byte(`{"name":"groups/123"}`),
+	}
+	failed := &cloudidentity.Operation{
+		Name:  "operations/1",
+		Done:  true,
+		Error: &cloudidentity.Status{Code: 7, Message: "permission denied"},
+	}
+
+	tests := map[string]struct {
+		operations []*cloudidentity.Operation
+		timeout    time.Duration
+		wantPolls  int
+		wantResult string
+		wantErr    string
+		wantOpErr  *operationError
+	}{
+		"done right away": {
+			operations: []*cloudidentity.Operation{succeeded},
+			timeout:    time.Minute,
+			wantPolls:  1,
+			wantResult: "groups/123",
+		},
+		"not done twice then done": {
+			operations: []*cloudidentity.Operation{pending, pending, succeeded},
+			timeout:    time.Minute,
+			wantPolls:  3,
+			wantResult: "groups/123",
+		},
+		"operation error": {
+			operations: []*cloudidentity.Operation{pending, failed},
+			timeout:    time.Minute,
+			wantPolls:  2,
+			wantErr:    "operation operations/1 failed with code 7: permission denied",
+			wantOpErr:  &operationError{Name: "operations/1", Code: 7, Message: "permission denied"},
+		},
+		"invalid response": {
+			operations: []*cloudidentity.Operation{{Name: "operations/1", Done: true, Response: []byte(`[]`)}},
+			timeout:    time.Minute,
+			wantPolls:  1,
+			wantErr:    "unable to decode the response of operation operations/1",
+		},
+		"timeout": {
+			operations: []*cloudidentity.Operation{pending},
+			timeout:    1500 * time.Millisecond,
+			wantPolls:  2,
+			wantErr:    "operation did not finish within 1.5s",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			poller := &fakeOperationPoller{operations: test.operations}
+
+			res, err := waitForOperation(context.Background(), test.timeout, poller.poll)
+
+			if poller.polls != test.wantPolls {
+				t.Errorf("got %d polls, want %d", poller.polls, test.wantPolls)
+			}
+			if res.Name != test.wantResult {
+				t.Errorf("got result %q, want %q", res.Name, test.wantResult)
+			}
+
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("got error %v, want it to contain %q", err, test.wantErr)
+			}
+
+			var opErr *operationError
+			if errors.As(err, &opErr) != (test.wantOpErr != nil) {
+				t.Fatalf("got error of type %T, want an *operationError: %t", err, test.wantOpErr != nil)
+			}
+			if test.wantOpErr != nil && *opErr != *test.wantOpErr {
+				t.Errorf("got operation error %+v, want %+v", *opErr, *test.wantOpErr)
+			}
+		})
+	}
+}
+
+func TestWaitForOperationPollError(t *testing.T) {
+	polls := 0
+	wantErr := errors.New("boom")
+
+	_, err := waitForOperation(context.Background(), time.Minute, func(ctx context.Context) (string, bool, error) {
+		polls++
+		return "", false, wantErr
+	})
+
+	if !errors.Is(err, wantErr) {
+		t.Errorf("got error %v, want %v", err, wantErr)
+	}
+	if polls != 1 {
+		t.Errorf("got %d polls, want 1", polls)
+	}
+}
+
+func TestWaitForOperationCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := waitForOperation(ctx, time.Minute, func(ctx context.Context) (string, bool, error) {
+		return "", false, nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestOperationErrorWithoutCode(t *testing.T) {
+	err := &operationError{Name: "groups/123", Message: "lookup failed"}
+
+	if got, want := err.Error(), "operation groups/123 failed: lookup failed"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrgUnitsDataSource{}
+
+func NewOrgUnitsDataSource() datasource.DataSource {
+	return &OrgUnitsDataSource{}
+}
+
+// OrgUnitsDataSource defines the data source implementation.
+type OrgUnitsDataSource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// OrgUnitsDataSourceModel describes the data source data model.
+type OrgUnitsDataSourceModel struct {
+	Customer    types.String   `tfsdk:"customer"`
+	OrgUnitPath types.String   `tfsdk:"org_unit_path"`
+	Type        types.String   `tfsdk:"type"`
+	OrgUnits    []OrgUnitModel `tfsdk:"org_units"`
+	Id          types.String   `tfsdk:"id"`
+}
+
+// Nested Model for "org_units".
+type OrgUnitModel struct {
+	Name              types.String `tfsdk:"name"`
+	OrgUnitPath       types.String `tfsdk:"org_unit_path"`
+	OrgUnitId         types.String `tfsdk:"org_unit_id"`
+	ParentOrgUnitPath types.String `tfsdk:"parent_org_unit_path"`
+	BlockInheritance  types.Bool   `tfsdk:"block_inheritance"`
+}
+
+func (d *OrgUnitsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_units"
+}
+
+func (d *OrgUnitsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the org units of a customer, sorted by org_unit_path" + scopesDescription(admin.AdminDirectoryOrgunitReadonlyScope),
+
+		Attributes: map[string]schema.Attribute{
+			"customer": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the customer's Google Workspace account. Defaults to the provider `customer_id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"org_unit_path": schema.StringAttribute{
+				MarkdownDescription: "The org unit to list the children of. Defaults to the root org unit `/`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: `Whether to list all org units below org_unit_path or only its direct
+				children, ` + "`all`" + ` or ` + "`children`" + `. Defaults to ` + "`all`" + `.`,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringOneOf("all", "children"),
+				},
+			},
+			"org_units": schema.ListNestedAttribute{
+				MarkdownDescription: "The org units, sorted by org_unit_path",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The org unit's name",
+							Computed:            true,
+						},
+						"org_unit_path": schema.StringAttribute{
+							MarkdownDescription: "The full path of the org unit",
+							Computed:            true,
+						},
+						"org_unit_id": schema.StringAttribute{
+							MarkdownDescription: "The unique ID of the org unit",
+							Computed:            true,
+						},
+						"parent_org_unit_path": schema.StringAttribute{
+							MarkdownDescription: "The full path of the parent org unit",
+							Computed:            true,
+						},
+						"block_inheritance": schema.BoolAttribute{
+							MarkdownDescription: "Whether the org unit blocks inheritance of settings from its parent",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrgUnitsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.adminService = pd.adminService
+}
+
+func (d *OrgUnitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrgUnitsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer := d.customerId
+	if !data.Customer.IsNull() && !data.Customer.IsUnknown() {
+		customer = data.Customer.ValueString()
+	}
+
+	orgUnitPath := "/"
+	if !data.OrgUnitPath.IsNull() && !data.OrgUnitPath.IsUnknown() {
+		orgUnitPath = data.OrgUnitPath.ValueString()
+	}
+
+	listType := "all"
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
+		listType = data.Type.ValueString()
+	}
+
+	res, err := d.adminService.Orgunits.List(customer).OrgUnitPath(orgUnitPath).Type(listType).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list org units under '%s' for customer '%s', got error: %s", orgUnitPath, customer, formatAPIError(err)),
+		)
+		return
+	}
+
+	sortByKey(res.OrganizationUnits, func(ou *admin.OrgUnit) string { return ou.OrgUnitPath })
+
+	data.OrgUnits = []OrgUnitModel{}
+	for _, ou := range res.OrganizationUnits {
+		data.OrgUnits = append(data.OrgUnits, OrgUnitModel{
+			Name:              types.StringValue(ou.Name),
+			OrgUnitPath:       types.StringValue(ou.OrgUnitPath),
+			OrgUnitId:         types.StringValue(ou.OrgUnitId),
+			ParentOrgUnitPath: types.StringValue(ou.ParentOrgUnitPath),
+			BlockInheritance:  types.BoolValue(ou.BlockInheritance),
+		})
+	}
+
+	data.Customer = types.StringValue(customer)
+	data.OrgUnitPath = types.StringValue(orgUnitPath)
+	data.Type = types.StringValue(listType)
+	data.Id = types.StringValue(customer + orgUnitPath)
+
+	tflog.Trace(ctx, "read org units", map[string]interface{}{
+		"customer":      customer,
+		"org_unit_path": orgUnitPath,
+		"count":         len(data.OrgUnits),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &PasswordEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &PasswordEphemeralResource{}
+
+func NewPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &PasswordEphemeralResource{}
+}
+
+// Google Workspace passwords must be between 8 and 100 ASCII characters.
+const (
+	defaultPasswordLength = 16
+	minPasswordLength     = 8
+	maxPasswordLength     = 100
+)
+
+const (
+	passwordLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordDigits  = "0123456789"
+	passwordSymbols = "!#$%&*+-=?@^_~"
+)
+
+// PasswordEphemeralResource defines the ephemeral resource implementation.
+type PasswordEphemeralResource struct{}
+
+// PasswordEphemeralResourceModel describes the ephemeral resource data model.
+type PasswordEphemeralResourceModel struct {
+	Length     types.Int64  `tfsdk:"length"`
+	MinDigits  types.Int64  `tfsdk:"min_digits"`
+	MinSymbols types.Int64  `tfsdk:"min_symbols"`
+	Password   types.String `tfsdk:"password"`
+	Sha1Hash   types.String `tfsdk:"sha1_hash"`
+}
+
+func (r *PasswordEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password"
+}
+
+func (r *PasswordEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Random password meeting the Google Workspace password rules, generated
+		with a cryptographically secure random number generator. The password is never stored
+		in the plan or state; pass sha1_hash to a user's password with hash_function set to
+		"SHA-1" so the plaintext is not sent either.`,
+
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "Length of the password, between 8 and 100. Defaults to 16.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64Between(minPasswordLength, maxPasswordLength),
+				},
+			},
+			"min_digits": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of digits in the password. Defaults to 0.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64Between(0, maxPasswordLength),
+				},
+			},
+			"min_symbols": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of symbols in the password. Defaults to 0.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64Between(0, maxPasswordLength),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The generated password",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"sha1_hash": schema.StringAttribute{
+				MarkdownDescription: "Hex encoded SHA-1 hash of the password",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *PasswordEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data PasswordEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Length.IsUnknown() || data.MinDigits.IsUnknown() || data.MinSymbols.IsUnknown() {
+		return
+	}
+
+	length, minDigits, minSymbols := passwordConstraints(&data)
+	if minDigits+minSymbols > length {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("length"),
+			"Invalid Password Length",
+			fmt.Sprintf("length must be at least min_digits + min_symbols (%d), got: %d", minDigits+minSymbols, length),
+		)
+	}
+}
+
+func (r *PasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data PasswordEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, err := generatePassword(passwordConstraints(&data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating Password",
+			fmt.Sprintf("Could not generate a password: %v", err),
+		)
+		return
+	}
+
+	hash := sha1.Sum([]byte(password))
+	data.Password = types.StringValue(password)
+	data.Sha1Hash = types.StringValue(hex.EncodeToString(hash[:]))
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// passwordConstraints returns the configured length, min_digits and
+// min_symbols, falling back to their defaults.
+func passwordConstraints(data *PasswordEphemeralResourceModel) (int, int, int) {
+	length := defaultPasswordLength
+	if !data.Length.IsNull() {
+		length = int(data.Length.ValueInt64())
+	}
+
+	return length, int(data.MinDigits.ValueInt64()), int(data.MinSymbols.ValueInt64())
+}
+
+// generatePassword returns a random password of length characters holding at
+// least minDigits digits and minSymbols symbols, the remaining characters are
+// drawn from letters, digits and symbols alike.
+func generatePassword(length, minDigits, minSymbols int) (string, error) {
+	if minDigits+minSymbols > length {
+		return "", fmt.Errorf("length %d is shorter than min_digits + min_symbols", length)
+	}
+
+	password := make([]byte, 0, length)
+	for _, charset := range []struct {
+		chars string
+		count int
+	}{
+		{passwordDigits, minDigits},
+		{passwordSymbols, minSymbols},
+		{passwordLetters + passwordDigits + passwordSymbols, length - minDigits - minSymbols},
+	} {
+		for range charset.count {
+			i, err := randomInt(len(charset.chars))
+			if err != nil {
+				return "", err
+			}
+			password = append(password, charset.chars[i])
+		}
+	}
+
+	// Shuffle so the required digits and symbols are not always in front.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// randomInt returns a uniformly distributed random integer in [0, n).
+func randomInt(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(v.Int64()), nil
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// openTestPassword opens the password ephemeral resource with config and
+// returns its result.
+func openTestPassword(t *testing.T, config PasswordEphemeralResourceModel) PasswordEphemeralResourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	r := NewPasswordEphemeralResource()
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	// State is the only data type with a Set, use it to build the raw config.
+	raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := raw.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("unexpected error building the config: %v", diags)
+	}
+
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: s, Raw: raw.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var result PasswordEphemeralResourceModel
+	if diags := resp.Result.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("unexpected error reading the result: %v", diags)
+	}
+
+	return result
+}
+
+func TestPasswordEphemeralResourceOpen(t *testing.T) {
+	const iterations = 200
+
+	tests := map[string]struct {
+		length     types.Int64
+		minDigits  types.Int64
+		minSymbols types.Int64
+		wantLength int
+	}{
+		"defaults": {
+			length:     types.Int64Null(),
+			minDigits:  types.Int64Null(),
+			minSymbols: types.Int64Null(),
+			wantLength: defaultPasswordLength,
+		},
+		"minimum length": {
+			length:     types.Int64Value(minPasswordLength),
+			minDigits:  types.Int64Value(1),
+			minSymbols: types.Int64Value(1),
+			wantLength: minPasswordLength,
+		},
+		"maximum length": {
+			length:     types.Int64Value(maxPasswordLength),
+			minDigits:  types.Int64Value(10),
+			minSymbols: types.Int64Value(10),
+			wantLength: maxPasswordLength,
+		},
+		"min_digits and min_symbols fill the length": {
+			length:     types.Int64Value(10),
+			minDigits:  types.Int64Value(6),
+			minSymbols: types.Int64Value(4),
+			wantLength: 10,
+		},
+		"digits only": {
+			length:     types.Int64Value(12),
+			minDigits:  types.Int64Value(12),
+			minSymbols: types.Int64Value(0),
+			wantLength: 12,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := PasswordEphemeralResourceModel{
+				Length:     test.length,
+				MinDigits:  test.minDigits,
+				MinSymbols: test.minSymbols,
+				Password:   types.StringNull(),
+				Sha1Hash:   types.StringNull(),
+			}
+
+			seen := map[string]bool{}
+			for range iterations {
+				result := openTestPassword(t, config)
+				password := result.Password.ValueString()
+
+				if len(password) != test.wantLength {
+					t.Fatalf("got password of length %d, want %d", len(password), test.wantLength)
+				}
+
+				var digits, symbols int
+				for _, c := range password {
+					switch {
+					case strings.ContainsRune(passwordDigits, c):
+						digits++
+					case strings.ContainsRune(passwordSymbols, c):
+						symbols++
+					case !strings.ContainsRune(passwordLetters, c):
+						t.Fatalf("got unexpected character %q in password %q", c, password)
+					}
+				}
+				if digits < int(test.minDigits.ValueInt64()) {
+					t.Fatalf("got %d digits in password %q, want at least %d", digits, password, test.minDigits.ValueInt64())
+				}
+				if symbols < int(test.minSymbols.ValueInt64()) {
+					t.Fatalf("got %d symbols in password %q, want at least %d", symbols, password, test.minSymbols.ValueInt64())
+				}
+
+				hash := sha1.Sum([]byte(password))
+				if want := hex.EncodeToString(hash[:]); result.Sha1Hash.ValueString() != want {
+					t.Fatalf("got sha1_hash %q, want %q", result.Sha1Hash.ValueString(), want)
+				}
+
+				seen[password] = true
+			}
+
+			if len(seen) != iterations {
+				t.Errorf("got %d distinct passwords out of %d", len(seen), iterations)
+			}
+		})
+	}
+}
+
+func TestGeneratePasswordTooShort(t *testing.T) {
+	if _, err := generatePassword(8, 5, 4); err == nil {
+		t.Error("expected an error when min_digits + min_symbols exceeds the length")
+	}
+}
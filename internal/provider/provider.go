@@ -5,21 +5,34 @@ package provider
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2"
 
+	datatransfer "google.golang.org/api/admin/datatransfer/v1"
 	admin "google.golang.org/api/admin/directory/v1"
+	reports "google.golang.org/api/admin/reports/v1"
+	chromepolicy "google.golang.org/api/chromepolicy/v1"
 	cloudidentity "google.golang.org/api/cloudidentity/v1"
+	cloudidentitybeta "google.golang.org/api/cloudidentity/v1beta1"
+	"google.golang.org/api/gmail/v1"
+	groupssettings "google.golang.org/api/groupssettings/v1"
+	"google.golang.org/api/licensing/v1"
 )
 
 // Ensure GoogleWorkspaceProvider satisfies various provider interfaces.
@@ -36,10 +49,50 @@ type GoogleWorkspaceProvider struct {
 	version string
 }
 
+// defaultOAuthScopes are requested when oauth_scopes is not configured. They
+// cover groups, users and Cloud Identity policies, resources needing other
+// scopes say so with scopesDescription.
+var defaultOAuthScopes = []string{
+	admin.AdminDirectoryGroupScope,
+	admin.AdminDirectoryUserScope,
+	cloudidentity.CloudIdentityPoliciesScope,
+}
+
+// scopesDescription returns the sentences documenting the OAuth scopes that a
+// resource, data source, action or ephemeral resource needs besides
+// defaultOAuthScopes, to append to its description. The scopes are not
+// requested by default since domain-wide delegation fails for every request
+// when a single requested scope isn't granted.
+func scopesDescription(scopes ...string) string {
+	quoted := make([]string, len(scopes))
+	for i, scope := range scopes {
+		quoted[i] = "`" + scope + "`"
+	}
+
+	if len(quoted) == 1 {
+		return fmt.Sprintf("\n\nRequires the %s OAuth scope, which is not requested by default. "+
+			"Add it to `oauth_scopes` along with the default scopes and grant it in the domain-wide delegation settings.", quoted[0])
+	}
+
+	return fmt.Sprintf("\n\nRequires the %s OAuth scopes, which are not requested by default. "+
+		"Add them to `oauth_scopes` along with the default scopes and grant them in the domain-wide delegation settings.", strings.Join(quoted, " and "))
+}
+
 // GoogleWorkspaceProviderModel describes the provider data model.
 type GoogleWorkspaceProviderModel struct {
-	Credentials           types.String `tfsdk:"credentials"`
-	ImpersonatedUserEmail types.String `tfsdk:"impersonated_user_email"`
+	Credentials                  types.String `tfsdk:"credentials"`
+	ImpersonatedUserEmail        types.String `tfsdk:"impersonated_user_email"`
+	OAuthScopes                  types.List   `tfsdk:"oauth_scopes"`
+	AccessToken                  types.String `tfsdk:"access_token"`
+	CustomerId                   types.String `tfsdk:"customer_id"`
+	RequestRetries               types.Int64  `tfsdk:"request_retries"`
+	RequestRetryDelay            types.String `tfsdk:"request_retry_delay"`
+	RequestTimeout               types.String `tfsdk:"request_timeout"`
+	ProxyUrl                     types.String `tfsdk:"proxy_url"`
+	ReadRetryTimeout             types.String `tfsdk:"read_retry_timeout"`
+	MembershipPropagationTimeout types.String `tfsdk:"membership_propagation_timeout"`
+	MaxConcurrency               types.Int64  `tfsdk:"max_concurrency"`
+	Endpoints                    types.Object `tfsdk:"endpoints"`
 }
 
 func (p *GoogleWorkspaceProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -51,13 +104,92 @@ func (p *GoogleWorkspaceProvider) Schema(ctx context.Context, req provider.Schem
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"credentials": schema.StringAttribute{
-				MarkdownDescription: "Path to Google Credentials JSON file (defaults to GOOGLE_CREDENTIALS)",
-				Required:            true,
+				MarkdownDescription: `Google service account key JSON, or the path to a file containing
+				it (defaults to GOOGLE_CREDENTIALS). When neither is set, Application Default
+				Credentials are used.`,
+				Optional:  true,
+				Sensitive: true,
 			},
 			"impersonated_user_email": schema.StringAttribute{
-				MarkdownDescription: "User to impersenate for domain-wide delegation (if applicable)",
-				Required:            true,
+				MarkdownDescription: "User to impersenate for domain-wide delegation (defaults to GOOGLEWORKSPACE_IMPERSONATED_USER_EMAIL)",
+				Optional:            true,
+			},
+			"oauth_scopes": schema.ListAttribute{
+				MarkdownDescription: `OAuth scopes requested for the impersonated user, replacing the default
+				scopes 'https://www.googleapis.com/auth/admin.directory.group',
+				'https://www.googleapis.com/auth/admin.directory.user' and
+				'https://www.googleapis.com/auth/cloud-identity.policies'. Resources and data sources
+				that need other scopes list them in their documentation, add those along with the
+				default scopes. Every scope needs the corresponding API to be enabled and must be
+				granted to the service account in the domain-wide delegation settings of the Admin
+				console.`,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: `A short-lived OAuth access token to authenticate with instead of
+				service account credentials. The token must already act as the admin user, so
+				impersonated_user_email and oauth_scopes are ignored. Conflicts with credentials.`,
+				Optional:  true,
+				Sensitive: true,
 			},
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: `ID of the Google Workspace customer to manage, as shown under
+				Account settings in the Admin console, e.g. 'C01abc23d' (defaults to
+				GOOGLEWORKSPACE_CUSTOMER_ID). Resellers use it to manage their customers' accounts.
+				When neither is set, the customer of the impersonated user ('my_customer') is used.`,
+				Optional: true,
+			},
+			"request_retries": schema.Int64Attribute{
+				MarkdownDescription: `Maximum number of times a request is retried after a rate limit
+				(429 or a 403 rate limit reason) or a 500, 502 or 503 error. Server errors are not
+				retried for POST and PATCH requests, which could be applied twice. Defaults to 5.`,
+				Optional: true,
+				Validators: []validator.Int64{
+					int64Between(0, 20),
+				},
+			},
+			"request_retry_delay": schema.StringAttribute{
+				MarkdownDescription: `Base delay of the exponential backoff between retries, as a duration
+				string such as '500ms' or '2s'. Defaults to '1s'. A Retry-After header sent by
+				Google takes precedence, up to 30s.`,
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: `Timeout of a single request to Google, as a duration string such as
+				'30s' or '2m'. Every retry gets its own timeout. Defaults to '30s', '0s' disables it.`,
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: `URL of the proxy to send every request to Google through, including
+				token requests, e.g. 'http://proxy.example.com:3128'. When set, the HTTP_PROXY,
+				HTTPS_PROXY and NO_PROXY environment variables are ignored.`,
+				Optional: true,
+			},
+			"read_retry_timeout": schema.StringAttribute{
+				MarkdownDescription: `How long to keep reading a freshly created group, user or membership
+				while Google reports it as not found, as a duration string such as '2m'. The APIs
+				are eventually consistent, so a new object can take a while to become readable.
+				Defaults to '2m'.`,
+				Optional: true,
+			},
+			"membership_propagation_timeout": schema.StringAttribute{
+				MarkdownDescription: `How long to wait for the members added by googleworkspace_group to
+				become readable, as a duration string such as '2m'. Google can report a new member
+				as not found for a while, which would leave the next read of the group without it.
+				Defaults to read_retry_timeout.`,
+				Optional: true,
+			},
+			"max_concurrency": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf(`Maximum number of requests a resource sends at once when
+				applying many changes, such as the members of a group. Defaults to %d. Requests that
+				hit a rate limit are retried according to request_retries.`, defaultMaxConcurrency),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+			"endpoints": endpointsSchema(),
 		},
 	}
 }
@@ -77,65 +209,515 @@ func (p *GoogleWorkspaceProvider) Configure(
 		return
 	}
 
-	b, err := os.ReadFile(data.Credentials.ValueString())
+	if !data.AccessToken.IsNull() && !data.Credentials.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Conflicting Provider Authentication",
+			"Only one of access_token and credentials can be set.",
+		)
+		return
+	}
+
+	if data.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Unknown Access Token",
+			"The access token must be known during provider configuration.",
+		)
+		return
+	}
+
+	if data.ProxyUrl.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Unknown Proxy URL",
+			"The proxy URL must be known during provider configuration.",
+		)
+		return
+	}
+
+	// httpClient is nil without a proxy, the oauth2 package then falls back to
+	// the default client which honours the proxy environment variables.
+	var httpClient *http.Client
+	if !data.ProxyUrl.IsNull() {
+		proxyURL, err := parseProxyURL(data.ProxyUrl.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Proxy URL",
+				fmt.Sprintf("The proxy URL must be a URL such as 'http://proxy.example.com:3128', got: %s: %s", data.ProxyUrl.ValueString(), err),
+			)
+			return
+		}
+		httpClient = &http.Client{Transport: newProxyTransport(proxyURL)}
+
+		// Token requests made while configuring go through the proxy too.
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	}
+
+	var ts oauth2.TokenSource
+	var newTokenSource delegatedTokenSource
+	if !data.AccessToken.IsNull() {
+		// The token was minted elsewhere for the user to act as, so there is
+		// nothing to impersonate and it is never refreshed.
+		ts = oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: data.AccessToken.ValueString(),
+		})
+	} else {
+		ts, newTokenSource = credentialsTokenSource(ctx, data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if httpClient != nil {
+			newTokenSource = newTokenSource.withHTTPClient(httpClient)
+		}
+	}
+
+	retries := defaultRequestRetries
+	if !data.RequestRetries.IsNull() {
+		retries = int(data.RequestRetries.ValueInt64())
+	}
+
+	retryDelay := defaultRequestRetryDelay
+	if !data.RequestRetryDelay.IsNull() {
+		d, err := time.ParseDuration(data.RequestRetryDelay.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_retry_delay"),
+				"Invalid Request Retry Delay",
+				"The request retry delay must be a duration string such as '1s': "+err.Error(),
+			)
+			return
+		}
+		retryDelay = d
+	}
+
+	requestTimeout := defaultRequestTimeout
+	if !data.RequestTimeout.IsNull() {
+		d, err := time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil || d < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("The request timeout must be a non-negative duration string such as '30s', got: %s", data.RequestTimeout.ValueString()),
+			)
+			return
+		}
+		requestTimeout = d
+	}
+
+	readRetryTimeout := defaultReadRetryTimeout
+	if !data.ReadRetryTimeout.IsNull() {
+		d, err := time.ParseDuration(data.ReadRetryTimeout.ValueString())
+		if err != nil || d < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_retry_timeout"),
+				"Invalid Read Retry Timeout",
+				fmt.Sprintf("The read retry timeout must be a non-negative duration string such as '2m', got: %s", data.ReadRetryTimeout.ValueString()),
+			)
+			return
+		}
+		readRetryTimeout = d
+	}
+
+	membershipPropagationTimeout := readRetryTimeout
+	if !data.MembershipPropagationTimeout.IsNull() {
+		d, err := time.ParseDuration(data.MembershipPropagationTimeout.ValueString())
+		if err != nil || d < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("membership_propagation_timeout"),
+				"Invalid Membership Propagation Timeout",
+				fmt.Sprintf("The membership propagation timeout must be a non-negative duration string such as '2m', got: %s", data.MembershipPropagationTimeout.ValueString()),
+			)
+			return
+		}
+		membershipPropagationTimeout = d
+	}
+
+	if data.CustomerId.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("customer_id"),
+			"Unknown Customer ID",
+			"The customer ID must be known during provider configuration. "+
+				"Either set a static value or use the GOOGLEWORKSPACE_CUSTOMER_ID environment variable.",
+		)
+		return
+	}
+
+	// The attribute takes precedence over the environment variable.
+	customerId := os.Getenv("GOOGLEWORKSPACE_CUSTOMER_ID")
+	if !data.CustomerId.IsNull() {
+		customerId = data.CustomerId.ValueString()
+	}
+	if customerId == "" {
+		customerId = defaultCustomerId
+	}
+
+	maxConcurrency := defaultMaxConcurrency
+	if !data.MaxConcurrency.IsNull() {
+		maxConcurrency = int(data.MaxConcurrency.ValueInt64())
+	}
+
+	endpoints := resolveEndpoints(data.Endpoints, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pd := &providerData{
+		httpClient:     httpClient,
+		customerId:     customerId,
+		endpoints:      endpoints,
+		newTokenSource: newTokenSource,
+		retries:        retries,
+		retryDelay:     retryDelay,
+		requestTimeout: requestTimeout,
+
+		readRetryTimeout:             readRetryTimeout,
+		membershipPropagationTimeout: membershipPropagationTimeout,
+		maxConcurrency:               maxConcurrency,
+	}
+
+	// Unless a static access token is used, this client automatically refreshes
+	// tokens acting as the impersonated user.
+	client := pd.newClient(ctx, ts)
+	pd.client = client
+
+	// Build the API clients once, every resource and data source shares them.
+	var err error
+	if pd.adminService, err = admin.NewService(ctx, pd.clientOptions(client, "directory")...); err != nil {
+		resp.Diagnostics.AddError("Unable to create Directory client", err.Error())
+		return
+	}
+	pd.userDeleteBatcher = newUserDeleteBatcher(client, pd.adminService)
+	if pd.datatransferService, err = datatransfer.NewService(ctx, pd.clientOptions(client, "data_transfer")...); err != nil {
+		resp.Diagnostics.AddError("Unable to create Data Transfer client", err.Error())
+		return
+	}
+	if pd.groupssettingsService, err = groupssettings.NewService(ctx, pd.clientOptions(client, "groups_settings")...); err != nil {
+		resp.Diagnostics.AddError("Unable to create Groups Settings client", err.Error())
+		return
+	}
+	if pd.cloudidentityService, err = cloudidentity.NewService(ctx, pd.clientOptions(client, "cloud_identity")...); err != nil {
+		resp.Diagnostics.AddError("Unable to create Cloud Identity client", err.Error())
+		return
+	}
+	if pd.cloudidentityBetaService, err = cloudidentitybeta.NewService(ctx, pd.clientOptions(client, "cloud_identity")...); err != nil {
+		resp.Diagnostics.AddError("Unable to create Cloud Identity client", err.Error())
+		return
+	}
+	if pd.chromepolicyService, err = chromepolicy.NewService(ctx, pd.clientOptions(client, "chrome_policy")...); err != nil {
+		resp.Diagnostics.AddError("Unable to create Chrome Policy client", err.Error())
+		return
+	}
+	if pd.licensingService, err = licensing.NewService(ctx, pd.clientOptions(client, "licensing")...); err != nil {
+		resp.Diagnostics.AddError("Unable to create Enterprise License Manager client", err.Error())
+		return
+	}
+	if pd.reportsService, err = reports.NewService(ctx, pd.clientOptions(client, "reports")...); err != nil {
+		resp.Diagnostics.AddError("Unable to create Reports client", err.Error())
+		return
+	}
+
+	resp.DataSourceData = pd
+	resp.ResourceData = pd
+	resp.ActionData = pd
+	resp.EphemeralResourceData = &ephemeralResourceData{
+		tokenSource:    ts,
+		newTokenSource: newTokenSource,
+		adminService:   pd.adminService,
+	}
+}
+
+// defaultCustomerId is the alias the Admin SDK resolves to the customer of
+// the impersonated user.
+const defaultCustomerId = "my_customer"
+
+// providerData is passed to resources, data sources and actions.
+type providerData struct {
+	client *http.Client
+
+	// httpClient sends the requests of client and of the token sources, it is
+	// nil unless a proxy is configured.
+	httpClient *http.Client
+
+	adminService             *admin.Service
+	datatransferService      *datatransfer.Service
+	groupssettingsService    *groupssettings.Service
+	cloudidentityService     *cloudidentity.Service
+	cloudidentityBetaService *cloudidentitybeta.Service
+	chromepolicyService      *chromepolicy.Service
+	licensingService         *licensing.Service
+	reportsService           *reports.Service
+
+	// userDeleteBatcher is shared so that concurrent deletes of users by
+	// different resources end up in the same batch.
+	userDeleteBatcher *userDeleteBatcher
+
+	// customerId is the customer that resources are managed in and data
+	// sources default to.
+	customerId string
+
+	// endpoints holds the overridden base URLs by service name.
+	endpoints map[string]string
+
+	// newTokenSource is nil when a static access_token is configured.
+	newTokenSource delegatedTokenSource
+	retries        int
+	retryDelay     time.Duration
+	requestTimeout time.Duration
+
+	// readRetryTimeout bounds how long resources wait for a created object to
+	// become readable.
+	readRetryTimeout time.Duration
+
+	// membershipPropagationTimeout bounds how long resources wait for a
+	// member added to a group to become readable.
+	membershipPropagationTimeout time.Duration
+
+	// maxConcurrency bounds how many requests a resource sends at once.
+	maxConcurrency int
+}
+
+// newClient returns a client authenticating with ts. Requests time out and
+// are retried according to the provider configuration.
+func (pd *providerData) newClient(ctx context.Context, ts oauth2.TokenSource) *http.Client {
+	if pd.httpClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, pd.httpClient)
+	}
+
+	client := oauth2.NewClient(ctx, ts)
+	client.Transport = newRetryTransport(newTimeoutTransport(client.Transport, pd.requestTimeout), pd.retries, pd.retryDelay)
+
+	return client
+}
+
+// userClient returns a client acting as subject rather than the impersonated
+// user, for APIs such as Gmail that only give access to the data of the
+// authenticated user.
+func (pd *providerData) userClient(ctx context.Context, subject string, scopes ...string) (*http.Client, error) {
+	if pd.newTokenSource == nil {
+		return nil, fmt.Errorf("acting as %s requires service account credentials or Application Default Credentials, "+
+			"an access_token only acts as the user it was minted for", subject)
+	}
+
+	ts, err := pd.newTokenSource(ctx, subject, scopes)
 	if err != nil {
-		log.Fatalf("Unable to read client secret file: %v", err)
+		return nil, err
 	}
 
-	config, err := google.JWTConfigFromJSON(b,
-		admin.AdminDirectoryGroupScope,
-		admin.AdminDirectoryUserScope,
-		cloudidentity.CloudIdentityPoliciesScope,
-	)
+	return pd.newClient(ctx, ts), nil
+}
+
+// gmailService returns a Gmail client acting as userId with the given scope.
+func (pd *providerData) gmailService(ctx context.Context, userId, scope string, diags *diag.Diagnostics) *gmail.Service {
+	client, err := pd.userClient(ctx, userId, scope)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to parse service account JSON",
-			"The provided credentials file is not valid JSON or missing fields: "+err.Error(),
+		diags.AddError(
+			"Unable to configure Google credentials",
+			err.Error(),
 		)
-		return
+		return nil
 	}
 
-	// 3. CRITICAL: Set the Subject (Domain-Wide Delegation)
-	// This explicitly tells Google: "I am this Service Account, but I want to act as THIS user."
-	if data.ImpersonatedUserEmail.IsNull() || data.ImpersonatedUserEmail.IsUnknown() {
-		resp.Diagnostics.AddError(
+	srv, err := gmail.NewService(ctx, pd.clientOptions(client, "gmail")...)
+	if err != nil {
+		diags.AddError(
+			"Unable to create Gmail client",
+			err.Error(),
+		)
+		return nil
+	}
+
+	return srv
+}
+
+// delegatedTokenSource returns a token source for the configured credentials
+// acting as subject with the given scopes. An empty subject stands for the
+// impersonated user.
+type delegatedTokenSource func(ctx context.Context, subject string, scopes []string) (oauth2.TokenSource, error)
+
+// withHTTPClient returns a delegatedTokenSource whose token sources request
+// tokens with client.
+func (f delegatedTokenSource) withHTTPClient(client *http.Client) delegatedTokenSource {
+	return func(ctx context.Context, subject string, scopes []string) (oauth2.TokenSource, error) {
+		return f(context.WithValue(ctx, oauth2.HTTPClient, client), subject, scopes)
+	}
+}
+
+// ephemeralResourceData is passed to ephemeral resources, which hand out
+// credentials such as tokens or verification codes.
+type ephemeralResourceData struct {
+	// tokenSource acts as the impersonated user with the provider scopes.
+	tokenSource oauth2.TokenSource
+
+	// newTokenSource is nil when a static access_token is configured, since
+	// such a token cannot be narrowed.
+	newTokenSource delegatedTokenSource
+
+	// adminService is used by ephemeral resources that generate credentials
+	// through the Directory API.
+	adminService *admin.Service
+}
+
+// credentialsTokenSource returns a token source acting as the impersonated
+// user through domain-wide delegation, using the configured service account
+// credentials or Application Default Credentials, along with a function
+// returning token sources for other users and scopes.
+func credentialsTokenSource(ctx context.Context, data GoogleWorkspaceProviderModel, diags *diag.Diagnostics) (oauth2.TokenSource, delegatedTokenSource) {
+	// The attribute takes precedence over the environment variable.
+	if data.ImpersonatedUserEmail.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("impersonated_user_email"),
+			"Unknown Impersonated User Email",
+			"The impersonated user email must be known during provider configuration. "+
+				"Either set a static value or use the GOOGLEWORKSPACE_IMPERSONATED_USER_EMAIL environment variable.",
+		)
+		return nil, nil
+	}
+
+	impersonatedUserEmail := os.Getenv("GOOGLEWORKSPACE_IMPERSONATED_USER_EMAIL")
+	if !data.ImpersonatedUserEmail.IsNull() {
+		impersonatedUserEmail = data.ImpersonatedUserEmail.ValueString()
+	}
+
+	if impersonatedUserEmail == "" {
+		diags.AddError(
 			"Missing Impersonated User Email",
-			"When using Domain-Wide Delegation, you must provide the email of the admin user to impersonate.",
+			"When using Domain-Wide Delegation, you must provide the email of the admin user to impersonate, "+
+				"either with the impersonated_user_email attribute or the GOOGLEWORKSPACE_IMPERSONATED_USER_EMAIL environment variable.",
 		)
-		return
+		return nil, nil
 	}
-	config.Subject = data.ImpersonatedUserEmail.ValueString()
-	// 4. Create the Client
-	// This client will now automatically refresh tokens acting as the 'Subject' user.
-	client := config.Client(ctx)
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if data.Credentials.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("credentials"),
+			"Unknown Credentials",
+			"The credentials must be known during provider configuration. "+
+				"Either set a static value, use the GOOGLE_CREDENTIALS environment variable or Application Default Credentials.",
+		)
+		return nil, nil
+	}
+
+	credentials := os.Getenv("GOOGLE_CREDENTIALS")
+	if !data.Credentials.IsNull() {
+		credentials = data.Credentials.ValueString()
+	}
+
+	scopes := defaultOAuthScopes
+	if !data.OAuthScopes.IsNull() {
+		var configuredScopes []string
+		diags.Append(data.OAuthScopes.ElementsAs(ctx, &configuredScopes, false)...)
+		if diags.HasError() {
+			return nil, nil
+		}
+		scopes = configuredScopes
+	}
+
+	// Fall back to Application Default Credentials when no key is provided, so
+	// the provider can run without any key material on disk.
+	newTokenSource := func(ctx context.Context, subject string, scopes []string) (oauth2.TokenSource, error) {
+		if subject == "" {
+			subject = impersonatedUserEmail
+		}
+		if credentials != "" {
+			return serviceAccountTokenSource(ctx, credentials, subject, scopes)
+		}
+		return defaultTokenSource(ctx, subject, scopes)
+	}
+
+	ts, err := newTokenSource(ctx, "", scopes)
+	if err != nil {
+		diags.AddError(
+			"Unable to configure Google credentials",
+			err.Error(),
+		)
+		return nil, nil
+	}
+
+	return ts, newTokenSource
 }
 
 func (p *GoogleWorkspaceProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewGroupResource,
+		NewUsersResource,
+		NewUserResource,
+		NewGroupSettingsResource,
+		NewCloudIdentityGroupResource,
+		NewCloudIdentityGroupMembershipResource,
+		NewCloudIdentityPolicyResource,
+		NewRoleAssignmentResource,
+		NewDomainResource,
+		NewSchemaResource,
+		NewBuildingResource,
+		NewChromeOsDeviceResource,
+		NewGmailFilterResource,
+		NewGmailForwardingAddressResource,
+		NewGmailAutoForwardingResource,
+		NewGmailVacationResource,
+		NewGmailImapPopResource,
+		NewDataTransferResource,
+		NewFeatureResource,
+		NewChromePolicyResource,
+		NewLicenseAssignmentResource,
 	}
 }
 
 func (p *GoogleWorkspaceProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewAuthTokenEphemeralResource,
+		NewPasswordEphemeralResource,
+		NewVerificationCodesEphemeralResource,
+	}
 }
 
 func (p *GoogleWorkspaceProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewGroupDataSource,
 		NewCloudIdentityPolicyDataSource,
+		NewCalendarBuildingsDataSource,
+		NewCalendarResourcesDataSource,
+		NewCloudIdentityDevicesDataSource,
+		NewUsersDataSource,
+		NewOrgUnitsDataSource,
+		NewGroupsDataSource,
+		NewGroupSettingsDataSource,
+		NewCustomerDataSource,
+		NewTransferApplicationsDataSource,
+		NewDomainAliasesDataSource,
+		NewAspsDataSource,
+		NewGmailSendAsDataSource,
+		NewGroupMembersExpandedDataSource,
+		NewChromePolicySchemasDataSource,
+		NewChromePolicySchemaDataSource,
+		NewLicenseAssignmentsDataSource,
+		NewUserSecuritySettingsDataSource,
+		NewReportsActivitiesDataSource,
+		NewUserUsageReportDataSource,
 	}
 }
 
 func (p *GoogleWorkspaceProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewCanonicalKeyFunction,
+		NewDirectoryQueryFunction,
+		NewNormalizeEmailFunction,
+		NewCelPolicyQueryFunction,
+		NewNormalizeOrgUnitPathFunction,
+	}
 }
 
 func (p *GoogleWorkspaceProvider) Actions(ctx context.Context) []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		NewSuspendUserAction,
+		NewSignOutUserAction,
+		NewMobileDeviceAction,
+		NewInvalidateVerificationCodesAction,
+		NewRevokeAspAction,
+		NewVerifySendAsAction,
+		NewUser2svAction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -3,6 +3,32 @@
 
 package provider
 
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	admin "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/option"
+)
+
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
 // The factory function is called for each Terraform CLI command to create a provider
 // server that the CLI can connect to and interact with.
@@ -24,3 +50,263 @@ package provider
 //	// about the appropriate environment variables being set are common to see in a pre-check
 //	// function.
 //}
+
+// newTestClientOptions returns the options of an API client sending its
+// requests to handler.
+func newTestClientOptions(t *testing.T, handler http.Handler) []option.ClientOption {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return []option.ClientOption{option.WithEndpoint(server.URL + "/"), option.WithHTTPClient(server.Client())}
+}
+
+// newTestAdminService returns a Directory API client sending its requests to
+// handler.
+func newTestAdminService(t *testing.T, handler http.Handler) *admin.Service {
+	t.Helper()
+
+	srv, err := admin.NewService(context.Background(), newTestClientOptions(t, handler)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return srv
+}
+
+// runTestFunction runs the provider function f with the arguments and
+// returns its result and error.
+func runTestFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+// newTestResourceState returns the state of the resource r holding the values
+// of model.
+func newTestResourceState(t *testing.T, r resource.Resource, model any) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+	s := resp.Schema
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("unexpected error building the state: %v", diags)
+	}
+
+	return state
+}
+
+// newTestResourceConfig returns the configuration of the resource r holding
+// the values of model.
+func newTestResourceConfig(t *testing.T, r resource.Resource, model any) tfsdk.Config {
+	t.Helper()
+
+	// State is the only data type with a Set, use it to build the raw value.
+	state := newTestResourceState(t, r, model)
+
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+// newTestResourcePlan returns the plan of the resource r holding the values
+// of model.
+func newTestResourcePlan(t *testing.T, r resource.Resource, model any) tfsdk.Plan {
+	t.Helper()
+
+	state := newTestResourceState(t, r, model)
+
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// testAPIResponse is the response of a testAPIHandler to a request.
+type testAPIResponse struct {
+	status int
+	body   string
+}
+
+// testAPIHandler returns a handler that answers requests with the response
+// for their method and path, e.g. "GET /admin/directory/v1/groups/123", and
+// records them in requests. Requests without a response fail the test.
+func testAPIHandler(t *testing.T, responses map[string]testAPIResponse, requests *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + r.URL.Path
+		*requests = append(*requests, request)
+
+		res, ok := responses[request]
+		if !ok {
+			t.Errorf("unexpected request %s", request)
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(res.status)
+		_, _ = w.Write([]byte(res.body))
+	})
+}
+
+func TestScopesDescription(t *testing.T) {
+	tests := map[string]struct {
+		scopes []string
+		want   string
+	}{
+		"one scope": {
+			scopes: []string{"https://www.googleapis.com/auth/admin.directory.domain"},
+			want: "\n\nRequires the `https://www.googleapis.com/auth/admin.directory.domain` OAuth scope, which is not requested by default. " +
+				"Add it to `oauth_scopes` along with the default scopes and grant it in the domain-wide delegation settings.",
+		},
+		"two scopes": {
+			scopes: []string{"https://www.googleapis.com/auth/apps.licensing", "https://www.googleapis.com/auth/admin.directory.customer.readonly"},
+			want: "\n\nRequires the `https://www.googleapis.com/auth/apps.licensing` and `https://www.googleapis.com/auth/admin.directory.customer.readonly` OAuth scopes, which are not requested by default. " +
+				"Add them to `oauth_scopes` along with the default scopes and grant them in the domain-wide delegation settings.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := scopesDescription(test.scopes...); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+// testServiceAccountKey returns a service account key that requests its
+// tokens from tokenURL.
+func testServiceAccountKey(t *testing.T, tokenURL string) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "terraform@example.iam.gserviceaccount.com",
+		"private_key_id": "1",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":      tokenURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(b)
+}
+
+func TestCredentialsTokenSourceImpersonatedUserEmail(t *testing.T) {
+	tests := map[string]struct {
+		env       string
+		attribute types.String
+		wantSub   string
+		wantErr   bool
+	}{
+		"environment variable only": {
+			env:       "env-admin@example.com",
+			attribute: types.StringNull(),
+			wantSub:   "env-admin@example.com",
+		},
+		"attribute overrides the environment variable": {
+			env:       "env-admin@example.com",
+			attribute: types.StringValue("admin@example.com"),
+			wantSub:   "admin@example.com",
+		},
+		"attribute only": {
+			attribute: types.StringValue("admin@example.com"),
+			wantSub:   "admin@example.com",
+		},
+		"neither": {
+			attribute: types.StringNull(),
+			wantErr:   true,
+		},
+		"unknown attribute": {
+			env:       "env-admin@example.com",
+			attribute: types.StringUnknown(),
+			wantErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("GOOGLEWORKSPACE_IMPERSONATED_USER_EMAIL", test.env)
+
+			// The subject is the "sub" claim of the JWT sent for a token.
+			var sub string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				parts := strings.Split(r.FormValue("assertion"), ".")
+				if len(parts) != 3 {
+					t.Errorf("got assertion %q, want a JWT", r.FormValue("assertion"))
+					return
+				}
+				payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+				if err != nil {
+					t.Errorf("unexpected error decoding the JWT: %s", err)
+					return
+				}
+				var claims struct {
+					Sub string `json:"sub"`
+				}
+				if err := json.Unmarshal(payload, &claims); err != nil {
+					t.Errorf("unexpected error decoding the JWT claims: %s", err)
+					return
+				}
+				sub = claims.Sub
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+			}))
+			t.Cleanup(server.Close)
+
+			data := GoogleWorkspaceProviderModel{
+				Credentials:           types.StringValue(testServiceAccountKey(t, server.URL)),
+				ImpersonatedUserEmail: test.attribute,
+				OAuthScopes:           types.ListNull(types.StringType),
+			}
+
+			var diags diag.Diagnostics
+			ts, _ := credentialsTokenSource(context.Background(), data, &diags)
+
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+
+			if _, err := ts.Token(); err != nil {
+				t.Fatalf("unexpected error getting a token: %s", err)
+			}
+			if sub != test.wantSub {
+				t.Errorf("got subject %q, want %q", sub, test.wantSub)
+			}
+		})
+	}
+}
+
+// TestProviderSchema validates the schemas of every resource, data source,
+// ephemeral resource, action and function, as Terraform does when it loads
+// the provider.
+func TestProviderSchema(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+}
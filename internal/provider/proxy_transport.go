@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// parseProxyURL parses a proxy URL such as 'http://proxy.example.com:3128'.
+// Only the schemes supported by net/http are accepted.
+func parseProxyURL(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("scheme must be one of http, https, socks5 or socks5h, got: %q", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("missing proxy host")
+	}
+
+	return u, nil
+}
+
+// newProxyTransport returns a copy of the default transport sending every
+// request through proxyURL, regardless of the HTTP_PROXY, HTTPS_PROXY and
+// NO_PROXY environment variables.
+func newProxyTransport(proxyURL *url.URL) http.RoundTripper {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = http.ProxyURL(proxyURL)
+
+	return t
+}
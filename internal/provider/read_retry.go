@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	// defaultReadRetryTimeout bounds how long a freshly created object may
+	// stay unreadable before readWithRetry gives up, unless the
+	// read_retry_timeout provider attribute is set.
+	defaultReadRetryTimeout = 2 * time.Minute
+
+	readRetryInitialInterval = 500 * time.Millisecond
+	readRetryMaxInterval     = 5 * time.Second
+)
+
+// isNotFound reports whether err is a 404 returned by a Google API.
+func isNotFound(err error) bool {
+	var googleErr *googleapi.Error
+	return errors.As(err, &googleErr) && googleErr.Code == http.StatusNotFound
+}
+
+// isConflict reports whether err is a 409 returned by a Google API, which is
+// how the Directory API reports that an email address is already taken.
+func isConflict(err error) bool {
+	var googleErr *googleapi.Error
+	return errors.As(err, &googleErr) && googleErr.Code == http.StatusConflict
+}
+
+// isForbidden reports whether err is a 403 returned by a Google API, e.g. when
+// the impersonated user lacks the admin privilege or the scope a call needs.
+func isForbidden(err error) bool {
+	var googleErr *googleapi.Error
+	return errors.As(err, &googleErr) && googleErr.Code == http.StatusForbidden
+}
+
+// readWithRetry calls read until it succeeds, returning early on any error
+// other than a 404. The Directory API is eventually consistent, so an object
+// that was just inserted may not be readable for a few seconds; 404s are
+// retried with a growing interval until timeout has elapsed, after which the
+// last error is returned.
+func readWithRetry[T any](ctx context.Context, timeout time.Duration, read func(context.Context) (T, error)) (T, error) {
+	deadline := time.Now().Add(timeout)
+	interval := readRetryInitialInterval
+
+	for {
+		res, err := read(ctx)
+		if err == nil || !isNotFound(err) || time.Now().Add(interval).After(deadline) {
+			return res, err
+		}
+
+		tflog.Debug(ctx, "Object not found yet, retrying read", map[string]interface{}{
+			"interval": interval.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return res, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > readRetryMaxInterval {
+			interval = readRetryMaxInterval
+		}
+	}
+}
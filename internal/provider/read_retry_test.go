@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+// fakeGetter fails with the errors in order, then succeeds with "found".
+type fakeGetter struct {
+	errs  []error
+	calls int
+}
+
+func (f *fakeGetter) get(ctx context.Context) (string, error) {
+	f.calls++
+	if f.calls <= len(f.errs) {
+		return "", f.errs[f.calls-1]
+	}
+
+	return "found", nil
+}
+
+func TestReadWithRetry(t *testing.T) {
+	notFound := &googleapi.Error{Code: http.StatusNotFound}
+	forbidden := &googleapi.Error{Code: http.StatusForbidden}
+
+	tests := map[string]struct {
+		errs      []error
+		timeout   time.Duration
+		wantCalls int
+		wantRes   string
+		wantErr   error
+	}{
+		"found right away": {
+			timeout:   time.Minute,
+			wantCalls: 1,
+			wantRes:   "found",
+		},
+		"404 once then found": {
+			errs:      []error{notFound},
+			timeout:   time.Minute,
+			wantCalls: 2,
+			wantRes:   "found",
+		},
+		"timeout runs out": {
+			errs:      []error{notFound, notFound, notFound, notFound},
+			timeout:   time.Second,
+			wantCalls: 2,
+			wantErr:   notFound,
+		},
+		"zero timeout reads once": {
+			errs:      []error{notFound},
+			timeout:   0,
+			wantCalls: 1,
+			wantErr:   notFound,
+		},
+		"other errors are not retried": {
+			errs:      []error{forbidden},
+			timeout:   time.Minute,
+			wantCalls: 1,
+			wantErr:   forbidden,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			getter := &fakeGetter{errs: test.errs}
+
+			res, err := readWithRetry(context.Background(), test.timeout, getter.get)
+
+			if getter.calls != test.wantCalls {
+				t.Errorf("got %d reads, want %d", getter.calls, test.wantCalls)
+			}
+			if res != test.wantRes {
+				t.Errorf("got result %q, want %q", res, test.wantRes)
+			}
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got error %v, want %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadWithRetryCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	getter := &fakeGetter{errs: []error{&googleapi.Error{Code: http.StatusNotFound}}}
+
+	_, err := readWithRetry(ctx, time.Minute, getter.get)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if getter.calls != 1 {
+		t.Errorf("got %d reads, want 1", getter.calls)
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	reports "google.golang.org/api/admin/reports/v1"
+)
+
+const defaultActivitiesMaxResults = 1000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ReportsActivitiesDataSource{}
+
+func NewReportsActivitiesDataSource() datasource.DataSource {
+	return &ReportsActivitiesDataSource{}
+}
+
+// ReportsActivitiesDataSource defines the data source implementation.
+type ReportsActivitiesDataSource struct {
+	client *http.Client
+
+	reportsService *reports.Service
+}
+
+// ReportsActivitiesDataSourceModel describes the data source data model.
+type ReportsActivitiesDataSourceModel struct {
+	ApplicationName types.String                          `tfsdk:"application_name"`
+	UserKey         types.String                          `tfsdk:"user_key"`
+	StartTime       types.String                          `tfsdk:"start_time"`
+	EndTime         types.String                          `tfsdk:"end_time"`
+	EventName       types.String                          `tfsdk:"event_name"`
+	MaxResults      types.Int64                           `tfsdk:"max_results"`
+	Activities      []ReportsActivitiesDataSourceActivity `tfsdk:"activities"`
+	Truncated       types.Bool                            `tfsdk:"truncated"`
+	Id              types.String                          `tfsdk:"id"`
+}
+
+// Nested Model for "activities".
+type ReportsActivitiesDataSourceActivity struct {
+	Time            types.String                       `tfsdk:"time"`
+	UniqueQualifier types.String                       `tfsdk:"unique_qualifier"`
+	ActorEmail      types.String                       `tfsdk:"actor_email"`
+	ActorProfileId  types.String                       `tfsdk:"actor_profile_id"`
+	IpAddress       types.String                       `tfsdk:"ip_address"`
+	Events          []ReportsActivitiesDataSourceEvent `tfsdk:"events"`
+}
+
+// Nested Model for "events".
+type ReportsActivitiesDataSourceEvent struct {
+	Name       types.String            `tfsdk:"name"`
+	Type       types.String            `tfsdk:"type"`
+	Parameters map[string]types.String `tfsdk:"parameters"`
+}
+
+func (d *ReportsActivitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reports_activities"
+}
+
+func (d *ReportsActivitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Activity events of an application from the Reports API, such as changes
+		made in the Admin console. Requires the admin.reports.audit.readonly scope.` + scopesDescription(reports.AdminReportsAuditReadonlyScope),
+
+		Attributes: map[string]schema.Attribute{
+			"application_name": schema.StringAttribute{
+				MarkdownDescription: "The application to list activities of, e.g. 'admin', 'login', 'drive' or 'groups'",
+				Required:            true,
+			},
+			"user_key": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address or profile ID to list activities of. Defaults to 'all'.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Only list activities at or after this RFC 3339 time, e.g. '2025-01-01T00:00:00Z'",
+				Optional:            true,
+				Validators: []validator.String{
+					stringIsTime(time.RFC3339),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "Only list activities before this RFC 3339 time. Defaults to now.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringIsTime(time.RFC3339),
+				},
+			},
+			"event_name": schema.StringAttribute{
+				MarkdownDescription: "Only list activities with an event of this name, e.g. 'CHANGE_GROUP_SETTING'",
+				Optional:            true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of activities to return. Defaults to %d.", defaultActivitiesMaxResults),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64Between(1, 10000),
+				},
+			},
+			"activities": schema.ListNestedAttribute{
+				MarkdownDescription: "The activities, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"time": schema.StringAttribute{
+							MarkdownDescription: "Time of the activity in RFC 3339 format",
+							Computed:            true,
+						},
+						"unique_qualifier": schema.StringAttribute{
+							MarkdownDescription: "Distinguishes activities that happened at the same time",
+							Computed:            true,
+						},
+						"actor_email": schema.StringAttribute{
+							MarkdownDescription: "Email address of the user who performed the activity",
+							Computed:            true,
+						},
+						"actor_profile_id": schema.StringAttribute{
+							MarkdownDescription: "Profile ID of the user who performed the activity",
+							Computed:            true,
+						},
+						"ip_address": schema.StringAttribute{
+							MarkdownDescription: "IP address the activity was performed from",
+							Computed:            true,
+						},
+						"events": schema.ListNestedAttribute{
+							MarkdownDescription: "The events of the activity",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Name of the event",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "Type of the event",
+										Computed:            true,
+									},
+									"parameters": schema.MapAttribute{
+										MarkdownDescription: `Parameters of the event by name. Lists and nested
+										messages are JSON encoded.`,
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Whether more activities matched than max_results",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier, in the format application_name/user_key",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ReportsActivitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.reportsService = pd.reportsService
+}
+
+func (d *ReportsActivitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReportsActivitiesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userKey := "all"
+	if !data.UserKey.IsNull() && !data.UserKey.IsUnknown() {
+		userKey = data.UserKey.ValueString()
+	}
+
+	maxResults := int64(defaultActivitiesMaxResults)
+	if !data.MaxResults.IsNull() && !data.MaxResults.IsUnknown() {
+		maxResults = data.MaxResults.ValueInt64()
+	}
+
+	applicationName := data.ApplicationName.ValueString()
+
+	call := d.reportsService.Activities.List(userKey, applicationName).MaxResults(min(maxResults, 1000))
+	if !data.StartTime.IsNull() {
+		call = call.StartTime(data.StartTime.ValueString())
+	}
+	if !data.EndTime.IsNull() {
+		call = call.EndTime(data.EndTime.ValueString())
+	}
+	if !data.EventName.IsNull() {
+		call = call.EventName(data.EventName.ValueString())
+	}
+
+	data.Activities = []ReportsActivitiesDataSourceActivity{}
+	data.Truncated = types.BoolValue(false)
+
+	// Pages are fetched one by one rather than with Pages, so listing can stop
+	// as soon as max_results activities are read.
+	for pageToken := ""; ; {
+		page, err := call.PageToken(pageToken).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list '%s' activities of '%s', got error: %s", applicationName, userKey, formatAPIError(err)),
+			)
+			return
+		}
+
+		for _, a := range page.Items {
+			if int64(len(data.Activities)) >= maxResults {
+				data.Truncated = types.BoolValue(true)
+				break
+			}
+			data.Activities = append(data.Activities, flattenActivity(a))
+		}
+
+		pageToken = page.NextPageToken
+		if pageToken == "" {
+			break
+		}
+		if int64(len(data.Activities)) >= maxResults {
+			data.Truncated = types.BoolValue(true)
+			break
+		}
+	}
+
+	data.UserKey = types.StringValue(userKey)
+	data.MaxResults = types.Int64Value(maxResults)
+	data.Id = types.StringValue(applicationName + "/" + userKey)
+
+	tflog.Trace(ctx, "read activities", map[string]interface{}{
+		"application_name": applicationName,
+		"user_key":         userKey,
+		"count":            len(data.Activities),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenActivity(a *reports.Activity) ReportsActivitiesDataSourceActivity {
+	activity := ReportsActivitiesDataSourceActivity{
+		Time:            types.StringNull(),
+		UniqueQualifier: types.StringNull(),
+		ActorEmail:      types.StringNull(),
+		ActorProfileId:  types.StringNull(),
+		IpAddress:       stringOrNull(a.IpAddress),
+		Events:          make([]ReportsActivitiesDataSourceEvent, 0, len(a.Events)),
+	}
+	if a.Id != nil {
+		activity.Time = types.StringValue(a.Id.Time)
+		activity.UniqueQualifier = types.StringValue(strconv.FormatInt(a.Id.UniqueQualifier, 10))
+	}
+	if a.Actor != nil {
+		activity.ActorEmail = stringOrNull(a.Actor.Email)
+		activity.ActorProfileId = stringOrNull(a.Actor.ProfileId)
+	}
+
+	for _, e := range a.Events {
+		event := ReportsActivitiesDataSourceEvent{
+			Name:       types.StringValue(e.Name),
+			Type:       stringOrNull(e.Type),
+			Parameters: make(map[string]types.String, len(e.Parameters)),
+		}
+		for _, p := range e.Parameters {
+			event.Parameters[p.Name] = types.StringValue(activityParameterValue(p))
+		}
+		activity.Events = append(activity.Events, event)
+	}
+
+	return activity
+}
+
+// activityParameterValue returns the value of an event parameter as a string.
+// Google leaves out zero values, a parameter without any value is reported as
+// false since booleans are the most common parameters.
+func activityParameterValue(p *reports.ActivityEventsParameters) string {
+	var v interface{}
+	switch {
+	case p.Value != "":
+		return p.Value
+	case p.IntValue != 0:
+		return strconv.FormatInt(p.IntValue, 10)
+	case len(p.MultiValue) > 0:
+		v = p.MultiValue
+	case len(p.MultiIntValue) > 0:
+		v = p.MultiIntValue
+	case p.MessageValue != nil:
+		v = p.MessageValue.Parameter
+	case len(p.MultiMessageValue) > 0:
+		v = p.MultiMessageValue
+	default:
+		return strconv.FormatBool(p.BoolValue)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
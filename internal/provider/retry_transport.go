@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultRequestRetries    = 5
+	defaultRequestRetryDelay = time.Second
+
+	maxRequestRetryDelay = 30 * time.Second
+)
+
+// retryTransport retries requests that failed because of rate limits or
+// transient server errors, with exponential backoff and jitter. A Retry-After
+// header sent by Google takes precedence over the computed delay, up to
+// maxRequestRetryDelay.
+type retryTransport struct {
+	base http.RoundTripper
+
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// newRetryTransport wraps base so that retryable responses are retried up to
+// maxRetries times.
+func newRetryTransport(base http.RoundTripper, maxRetries int, baseDelay time.Duration) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &retryTransport{
+		base:       base,
+		maxRetries: maxRetries,
+		baseDelay:  baseDelay,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		// The body was consumed by the previous attempt, rewind it.
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || attempt >= t.maxRetries || !isRetryableResponse(req.Method, resp) {
+			return resp, err
+		}
+
+		// Requests whose body can't be replayed are not retried.
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		delay := t.backoff(attempt, resp)
+
+		tflog.Debug(ctx, "Retrying Google API request", map[string]interface{}{
+			"url":     req.URL.String(),
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"delay":   delay.String(),
+		})
+
+		// Drain the body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// backoff returns how long to wait before retrying after attempt failed. A
+// Retry-After header is honored up to maxRequestRetryDelay, so that a long
+// one doesn't stall the apply.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if delay, ok := retryAfter(resp); ok {
+		return min(delay, maxRequestRetryDelay)
+	}
+
+	delay := t.baseDelay << attempt
+	if delay <= 0 || delay > maxRequestRetryDelay {
+		delay = maxRequestRetryDelay
+	}
+
+	// Add up to 50% jitter so concurrent requests don't retry in lockstep.
+	return delay + rand.N(delay/2+1)
+}
+
+// retryAfter parses the Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// isRetryableResponse reports whether resp to a request with the given method
+// is a rate limit or transient server error. Google reports some rate limits
+// as 403s, which are told apart from permission errors by their reason. A
+// server error may come after the request was applied, so only requests that
+// are safe to repeat are retried on one, e.g. a retried POST could create a
+// second alias or member.
+func isRetryableResponse(method string, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable:
+		return isIdempotent(method)
+	case http.StatusForbidden:
+		return isRateLimitResponse(resp)
+	default:
+		return false
+	}
+}
+
+// isIdempotent reports whether repeating a request with method has the same
+// effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// rateLimitReasons are the error reasons Google returns with a 403 when a
+// rate limit was exceeded.
+var rateLimitReasons = map[string]bool{
+	"rateLimitExceeded":     true,
+	"userRateLimitExceeded": true,
+}
+
+// isRateLimitResponse inspects the error reasons of a 403 response. The body
+// is restored afterwards so it can still be decoded by the caller.
+func isRateLimitResponse(resp *http.Response) bool {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var apiErr struct {
+		Error struct {
+			Errors []struct {
+				Reason string `json:"reason"`
+			} `json:"errors"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &apiErr); err != nil {
+		return false
+	}
+
+	for _, e := range apiErr.Error.Errors {
+		if rateLimitReasons[e.Reason] {
+			return true
+		}
+	}
+
+	return false
+}
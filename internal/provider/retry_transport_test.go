@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubResponse is a response returned by stubTransport.
+type stubResponse struct {
+	status     int
+	retryAfter string
+	body       string
+}
+
+// stubTransport returns its responses in order, repeating the last one, and
+// counts the requests it received.
+type stubTransport struct {
+	responses []stubResponse
+	requests  int
+	bodies    []string
+}
+
+func (s *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		s.bodies = append(s.bodies, string(b))
+	}
+
+	r := s.responses[min(s.requests, len(s.responses)-1)]
+	s.requests++
+
+	resp := &http.Response{
+		StatusCode: r.status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(r.body)),
+		Request:    req,
+	}
+	if r.retryAfter != "" {
+		resp.Header.Set("Retry-After", r.retryAfter)
+	}
+
+	return resp, nil
+}
+
+func TestRetryTransport(t *testing.T) {
+	const rateLimitBody = `{"error":{"errors":[{"reason":"rateLimitExceeded"}]}}`
+	const forbiddenBody = `{"error":{"errors":[{"reason":"forbidden"}]}}`
+
+	tests := map[string]struct {
+		method       string
+		responses    []stubResponse
+		maxRetries   int
+		baseDelay    time.Duration
+		wantRequests int
+		wantStatus   int
+	}{
+		"429 twice then 200": {
+			responses:    []stubResponse{{status: 429}, {status: 429}, {status: 200}},
+			maxRetries:   5,
+			baseDelay:    time.Millisecond,
+			wantRequests: 3,
+			wantStatus:   200,
+		},
+		"Retry-After takes precedence over the backoff": {
+			// The backoff would wait an hour, the test times out long before.
+			responses:    []stubResponse{{status: 429, retryAfter: "0"}, {status: 429, retryAfter: "0"}, {status: 200}},
+			maxRetries:   5,
+			baseDelay:    time.Hour,
+			wantRequests: 3,
+			wantStatus:   200,
+		},
+		"stops at request_retries": {
+			responses:    []stubResponse{{status: 429}},
+			maxRetries:   2,
+			baseDelay:    time.Millisecond,
+			wantRequests: 3,
+			wantStatus:   429,
+		},
+		"no retries": {
+			responses:    []stubResponse{{status: 429}, {status: 200}},
+			maxRetries:   0,
+			baseDelay:    time.Millisecond,
+			wantRequests: 1,
+			wantStatus:   429,
+		},
+		"server error is retried": {
+			method:       http.MethodPut,
+			responses:    []stubResponse{{status: 503}, {status: 200}},
+			maxRetries:   5,
+			baseDelay:    time.Millisecond,
+			wantRequests: 2,
+			wantStatus:   200,
+		},
+		"server error is not retried for POST": {
+			responses:    []stubResponse{{status: 503}, {status: 200}},
+			maxRetries:   5,
+			baseDelay:    time.Millisecond,
+			wantRequests: 1,
+			wantStatus:   503,
+		},
+		"server error is not retried for PATCH": {
+			method:       http.MethodPatch,
+			responses:    []stubResponse{{status: 500}, {status: 200}},
+			maxRetries:   5,
+			baseDelay:    time.Millisecond,
+			wantRequests: 1,
+			wantStatus:   500,
+		},
+		"rate limit reported as 403 is retried": {
+			responses:    []stubResponse{{status: 403, body: rateLimitBody}, {status: 200}},
+			maxRetries:   5,
+			baseDelay:    time.Millisecond,
+			wantRequests: 2,
+			wantStatus:   200,
+		},
+		"permission error is not retried": {
+			responses:    []stubResponse{{status: 403, body: forbiddenBody}, {status: 200}},
+			maxRetries:   5,
+			baseDelay:    time.Millisecond,
+			wantRequests: 1,
+			wantStatus:   403,
+		},
+		"client error is not retried": {
+			responses:    []stubResponse{{status: 400}, {status: 200}},
+			maxRetries:   5,
+			baseDelay:    time.Millisecond,
+			wantRequests: 1,
+			wantStatus:   400,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			stub := &stubTransport{responses: test.responses}
+			transport := newRetryTransport(stub, test.maxRetries, test.baseDelay)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			method := test.method
+			if method == "" {
+				method = http.MethodPost
+			}
+
+			req, err := http.NewRequestWithContext(ctx, method, "https://example.com/", strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer resp.Body.Close()
+
+			if stub.requests != test.wantRequests {
+				t.Errorf("got %d requests, want %d", stub.requests, test.wantRequests)
+			}
+			if resp.StatusCode != test.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, test.wantStatus)
+			}
+			for i, body := range stub.bodies {
+				if body != "payload" {
+					t.Errorf("request %d got body %q, want the replayed payload", i+1, body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := &retryTransport{baseDelay: time.Second}
+
+	tests := map[string]struct {
+		attempt    int
+		retryAfter string
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		"first attempt": {
+			attempt: 0,
+			wantMin: time.Second,
+			wantMax: 1500 * time.Millisecond,
+		},
+		"doubles per attempt": {
+			attempt: 2,
+			wantMin: 4 * time.Second,
+			wantMax: 6 * time.Second,
+		},
+		"capped": {
+			attempt: 10,
+			wantMin: maxRequestRetryDelay,
+			wantMax: maxRequestRetryDelay * 3 / 2,
+		},
+		"Retry-After in seconds": {
+			attempt:    3,
+			retryAfter: "7",
+			wantMin:    7 * time.Second,
+			wantMax:    7 * time.Second,
+		},
+		"Retry-After capped": {
+			attempt:    0,
+			retryAfter: "3600",
+			wantMin:    maxRequestRetryDelay,
+			wantMax:    maxRequestRetryDelay,
+		},
+		"invalid Retry-After falls back to the backoff": {
+			attempt:    0,
+			retryAfter: "soon",
+			wantMin:    time.Second,
+			wantMax:    1500 * time.Millisecond,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.retryAfter != "" {
+				resp.Header.Set("Retry-After", test.retryAfter)
+			}
+
+			got := transport.backoff(test.attempt, resp)
+			if got < test.wantMin || got > test.wantMax {
+				t.Errorf("got delay %s, want between %s and %s", got, test.wantMin, test.wantMax)
+			}
+		})
+	}
+}
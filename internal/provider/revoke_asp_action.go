@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &RevokeAspAction{}
+var _ action.ActionWithConfigure = &RevokeAspAction{}
+
+func NewRevokeAspAction() action.Action {
+	return &RevokeAspAction{}
+}
+
+// RevokeAspAction defines the action implementation.
+type RevokeAspAction struct {
+	client *http.Client
+
+	adminService *admin.Service
+}
+
+// RevokeAspActionModel describes the action data model.
+type RevokeAspActionModel struct {
+	UserKey types.String `tfsdk:"user_key"`
+	CodeId  types.Int64  `tfsdk:"code_id"`
+}
+
+func (a *RevokeAspAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_revoke_asp"
+}
+
+func (a *RevokeAspAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Revokes an application-specific password (ASP) of a user, as listed by
+		the googleworkspace_asps data source. Applications using the ASP can no longer sign in.` + scopesDescription(admin.AdminDirectoryUserSecurityScope),
+
+		Attributes: map[string]schema.Attribute{
+			"user_key": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address, alias email address, or unique user ID",
+				Required:            true,
+			},
+			"code_id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the ASP to revoke",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *RevokeAspAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = pd.client
+	a.adminService = pd.adminService
+}
+
+func (a *RevokeAspAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RevokeAspActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userKey := data.UserKey.ValueString()
+	codeId := data.CodeId.ValueInt64()
+
+	err := a.adminService.Asps.Delete(userKey, codeId).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"ASP Not Found",
+				fmt.Sprintf("User %s has no ASP with code ID %d.", userKey, codeId),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Revoking ASP",
+			fmt.Sprintf("Could not revoke ASP %d of user %s: %v", codeId, userKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Revoked ASP", map[string]interface{}{
+		"user_key": userKey,
+		"code_id":  codeId,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Revoked ASP %d of user %s", codeId, userKey),
+	})
+}
@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleAssignmentResource{}
+var _ resource.ResourceWithImportState = &RoleAssignmentResource{}
+var _ resource.ResourceWithValidateConfig = &RoleAssignmentResource{}
+
+func NewRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{}
+}
+
+// RoleAssignmentResource defines the resource implementation.
+type RoleAssignmentResource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// RoleAssignmentResourceModel describes the resource data model.
+type RoleAssignmentResourceModel struct {
+	RoleId       types.String `tfsdk:"role_id"`
+	AssignedTo   types.String `tfsdk:"assigned_to"`
+	ScopeType    types.String `tfsdk:"scope_type"`
+	OrgUnitId    types.String `tfsdk:"org_unit_id"`
+	AssigneeType types.String `tfsdk:"assignee_type"`
+	Etag         types.String `tfsdk:"etag"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (r *RoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_assignment"
+}
+
+func (r *RoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Admin role assignment resource. Role assignments cannot be modified,
+		changing any attribute replaces the assignment.` + scopesDescription(admin.AdminDirectoryRolemanagementScope),
+
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the role that is assigned",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assigned_to": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the user or group the role is assigned to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope_type": schema.StringAttribute{
+				MarkdownDescription: "The scope in which the role is assigned, CUSTOMER or ORG_UNIT. Defaults to CUSTOMER.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("CUSTOMER"),
+				Validators: []validator.String{
+					stringOneOf("CUSTOMER", "ORG_UNIT"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_unit_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the org unit the assignment is restricted to, required when scope_type is ORG_UNIT",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assignee_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the assignee, USER or GROUP",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"etag": schema.StringAttribute{
+				MarkdownDescription: "ETag of the role assignment",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Role assignment identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RoleAssignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ScopeType.ValueString() == "ORG_UNIT" && data.OrgUnitId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("org_unit_id"),
+			"Missing Org Unit",
+			"org_unit_id must be set when scope_type is ORG_UNIT.",
+		)
+	}
+
+	if !data.RoleId.IsUnknown() && !data.RoleId.IsNull() {
+		if _, err := strconv.ParseInt(data.RoleId.ValueString(), 10, 64); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role_id"),
+				"Invalid Role ID",
+				fmt.Sprintf("role_id must be a numeric role ID, got: %s", data.RoleId.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *RoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.customerId = pd.customerId
+	r.adminService = pd.adminService
+}
+
+func (r *RoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId, err := strconv.ParseInt(data.RoleId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_id"),
+			"Invalid Role ID",
+			fmt.Sprintf("role_id must be a numeric role ID, got: %s", data.RoleId.ValueString()),
+		)
+		return
+	}
+
+	ra := &admin.RoleAssignment{
+		RoleId:     roleId,
+		AssignedTo: data.AssignedTo.ValueString(),
+		ScopeType:  data.ScopeType.ValueString(),
+		OrgUnitId:  data.OrgUnitId.ValueString(),
+	}
+
+	res, err := r.adminService.RoleAssignments.Insert(r.customerId, ra).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Role Assignment",
+			fmt.Sprintf("Could not assign role %s to %s: %v", data.RoleId.ValueString(), data.AssignedTo.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenRoleAssignment(res, &data)
+
+	tflog.Trace(ctx, "Created Role Assignment", map[string]interface{}{
+		"id":          data.Id.ValueString(),
+		"role_id":     data.RoleId.ValueString(),
+		"assigned_to": data.AssignedTo.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.RoleAssignments.Get(r.customerId, data.Id.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Role Assignment was revoked outside of Terraform, removing from state", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read role assignment '%s', got error: %s", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenRoleAssignment(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes, every configurable attribute requires
+// replacement.
+func (r *RoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.adminService.RoleAssignments.Delete(r.customerId, data.Id.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "Role Assignment already deleted", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Role Assignment",
+			fmt.Sprintf("Could not delete role assignment %s: %v", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *RoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func flattenRoleAssignment(ra *admin.RoleAssignment, data *RoleAssignmentResourceModel) {
+	data.Id = types.StringValue(strconv.FormatInt(ra.RoleAssignmentId, 10))
+	data.RoleId = types.StringValue(strconv.FormatInt(ra.RoleId, 10))
+	data.AssignedTo = types.StringValue(ra.AssignedTo)
+	data.ScopeType = types.StringValue(ra.ScopeType)
+	data.AssigneeType = types.StringValue(ra.AssigneeType)
+	data.Etag = types.StringValue(ra.Etag)
+
+	if ra.OrgUnitId != "" {
+		data.OrgUnitId = types.StringValue(ra.OrgUnitId)
+	} else {
+		data.OrgUnitId = types.StringNull()
+	}
+}
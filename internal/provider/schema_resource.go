@@ -0,0 +1,376 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SchemaResource{}
+var _ resource.ResourceWithImportState = &SchemaResource{}
+var _ resource.ResourceWithModifyPlan = &SchemaResource{}
+
+func NewSchemaResource() resource.Resource {
+	return &SchemaResource{}
+}
+
+// SchemaResource defines the resource implementation.
+type SchemaResource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// SchemaResourceModel describes the resource data model.
+type SchemaResourceModel struct {
+	SchemaName  types.String               `tfsdk:"schema_name"`
+	DisplayName types.String               `tfsdk:"display_name"`
+	Fields      []SchemaResourceFieldModel `tfsdk:"fields"`
+	Etag        types.String               `tfsdk:"etag"`
+	Id          types.String               `tfsdk:"id"`
+}
+
+// Nested Model for "fields".
+type SchemaResourceFieldModel struct {
+	FieldName      types.String `tfsdk:"field_name"`
+	FieldType      types.String `tfsdk:"field_type"`
+	MultiValued    types.Bool   `tfsdk:"multi_valued"`
+	Indexed        types.Bool   `tfsdk:"indexed"`
+	ReadAccessType types.String `tfsdk:"read_access_type"`
+}
+
+func (r *SchemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema"
+}
+
+func (r *SchemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom user schema resource" + scopesDescription(admin.AdminDirectoryUserschemaScope),
+
+		Attributes: map[string]schema.Attribute{
+			"schema_name": schema.StringAttribute{
+				MarkdownDescription: "The schema's name, used to reference its fields on users",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the schema",
+				Required:            true,
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: `Fields of the schema. Fields can be added in place, removing a field
+				deletes its values from every user.`,
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the field",
+							Required:            true,
+						},
+						"field_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the field",
+							Required:            true,
+							Validators: []validator.String{
+								stringOneOf("STRING", "INT64", "BOOL", "DOUBLE", "EMAIL", "PHONE", "DATE"),
+							},
+						},
+						"multi_valued": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field holds a list of values. Defaults to false.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"indexed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field can be used in user search queries. Defaults to true.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"read_access_type": schema.StringAttribute{
+							MarkdownDescription: "Who can read the field, ALL_DOMAIN_USERS or ADMINS_AND_SELF. Defaults to ALL_DOMAIN_USERS.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("ALL_DOMAIN_USERS"),
+							Validators: []validator.String{
+								stringOneOf("ALL_DOMAIN_USERS", "ADMINS_AND_SELF"),
+							},
+						},
+					},
+				},
+			},
+			"etag": schema.StringAttribute{
+				MarkdownDescription: "ETag of the schema",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Schema identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.customerId = pd.customerId
+	r.adminService = pd.adminService
+}
+
+// ModifyPlan warns about fields that are removed from the schema, since that
+// deletes the data stored in them for every user.
+func (r *SchemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SchemaResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := map[string]bool{}
+	for _, f := range plan.Fields {
+		planned[f.FieldName.ValueString()] = true
+	}
+
+	for _, f := range state.Fields {
+		if planned[f.FieldName.ValueString()] {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("fields"),
+			"Schema Field Removed",
+			fmt.Sprintf("Field %s will be removed from schema %s, deleting its values from every user.",
+				f.FieldName.ValueString(), state.SchemaName.ValueString()),
+		)
+	}
+}
+
+func (r *SchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SchemaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Schemas.Insert(r.customerId, expandSchema(&data)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Schema",
+			fmt.Sprintf("Could not create schema %s: %v", data.SchemaName.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenSchema(res, &data)
+
+	tflog.Trace(ctx, "Created Schema", map[string]interface{}{
+		"id":          res.SchemaId,
+		"schema_name": res.SchemaName,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SchemaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Schemas.Get(r.customerId, data.Id.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Schema no longer exists in Google Workspace, removing from state", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read schema '%s', got error: %s", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenSchema(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SchemaResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.adminService.Schemas.Update(r.customerId, data.Id.ValueString(), expandSchema(&data)).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Schema",
+			fmt.Sprintf("Could not update schema %s: %v", data.SchemaName.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	flattenSchema(res, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SchemaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.adminService.Schemas.Delete(r.customerId, data.Id.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "Schema already deleted in Google Workspace", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Schema",
+			fmt.Sprintf("Could not delete schema %s: %v", data.SchemaName.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+func (r *SchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandSchema(data *SchemaResourceModel) *admin.Schema {
+	s := &admin.Schema{
+		SchemaName:  data.SchemaName.ValueString(),
+		DisplayName: data.DisplayName.ValueString(),
+	}
+
+	for _, f := range data.Fields {
+		indexed := f.Indexed.ValueBool()
+		s.Fields = append(s.Fields, &admin.SchemaFieldSpec{
+			FieldName:      f.FieldName.ValueString(),
+			FieldType:      f.FieldType.ValueString(),
+			MultiValued:    f.MultiValued.ValueBool(),
+			Indexed:        &indexed,
+			ReadAccessType: f.ReadAccessType.ValueString(),
+		})
+	}
+
+	return s
+}
+
+// flattenSchema stores s in data. Fields are kept in the order they already
+// have in data, fields unknown to data are appended sorted by name, so the
+// order does not depend on the order returned by Google.
+func flattenSchema(s *admin.Schema, data *SchemaResourceModel) {
+	data.Id = types.StringValue(s.SchemaId)
+	data.SchemaName = types.StringValue(s.SchemaName)
+	data.DisplayName = types.StringValue(s.DisplayName)
+	data.Etag = types.StringValue(s.Etag)
+
+	position := map[string]int{}
+	for i, f := range data.Fields {
+		position[f.FieldName.ValueString()] = i
+	}
+
+	fields := make([]*admin.SchemaFieldSpec, len(s.Fields))
+	copy(fields, s.Fields)
+	sort.SliceStable(fields, func(i, j int) bool {
+		pi, iok := position[fields[i].FieldName]
+		pj, jok := position[fields[j].FieldName]
+		switch {
+		case iok && jok:
+			return pi < pj
+		case iok != jok:
+			return iok
+		default:
+			return fields[i].FieldName < fields[j].FieldName
+		}
+	})
+
+	data.Fields = make([]SchemaResourceFieldModel, 0, len(fields))
+	for _, f := range fields {
+		// Fields are indexed unless Google says otherwise.
+		indexed := f.Indexed == nil || *f.Indexed
+
+		data.Fields = append(data.Fields, SchemaResourceFieldModel{
+			FieldName:      types.StringValue(f.FieldName),
+			FieldType:      types.StringValue(f.FieldType),
+			MultiValued:    types.BoolValue(f.MultiValued),
+			Indexed:        types.BoolValue(indexed),
+			ReadAccessType: types.StringValue(f.ReadAccessType),
+		})
+	}
+}
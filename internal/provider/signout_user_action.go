@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &SignOutUserAction{}
+var _ action.ActionWithConfigure = &SignOutUserAction{}
+
+func NewSignOutUserAction() action.Action {
+	return &SignOutUserAction{}
+}
+
+// SignOutUserAction defines the action implementation.
+type SignOutUserAction struct {
+	client *http.Client
+
+	adminService *admin.Service
+}
+
+// SignOutUserActionModel describes the action data model.
+type SignOutUserActionModel struct {
+	UserKey types.String `tfsdk:"user_key"`
+}
+
+func (a *SignOutUserAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_signout_user"
+}
+
+func (a *SignOutUserAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Signs a user out of all web and device sessions and resets their
+		sign-in cookies, so the user has to authenticate again. Signing out a user without
+		sessions has no effect.` + scopesDescription(admin.AdminDirectoryUserSecurityScope),
+
+		Attributes: map[string]schema.Attribute{
+			"user_key": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address, alias email address, or unique user ID",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *SignOutUserAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = pd.client
+	a.adminService = pd.adminService
+}
+
+func (a *SignOutUserAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data SignOutUserActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userKey := data.UserKey.ValueString()
+
+	err := a.adminService.Users.SignOut(userKey).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"User Not Found",
+				fmt.Sprintf("User %s does not exist in Google Workspace.", userKey),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Signing Out User",
+			fmt.Sprintf("Could not sign out user %s: %v", userKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Signed out user", map[string]interface{}{
+		"user_key": userKey,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Signed out user %s from all sessions", userKey),
+	})
+}
@@ -0,0 +1,18 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"slices"
+)
+
+// sortByKey sorts s in place by the key of its elements. Most Google APIs
+// return lists in no particular order, data sources sort the lists they
+// return so that plans referencing them are stable.
+func sortByKey[T any, K cmp.Ordered](s []T, key func(T) K) {
+	slices.SortStableFunc(s, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortByKey(t *testing.T) {
+	type member struct {
+		email string
+		role  string
+	}
+
+	tests := map[string]struct {
+		members []member
+		want    []member
+	}{
+		"empty": {},
+		"sorted by key": {
+			members: []member{{"c@example.com", "MEMBER"}, {"a@example.com", "OWNER"}, {"b@example.com", "MEMBER"}},
+			want:    []member{{"a@example.com", "OWNER"}, {"b@example.com", "MEMBER"}, {"c@example.com", "MEMBER"}},
+		},
+		"equal keys keep their order": {
+			members: []member{{"b@example.com", "MEMBER"}, {"a@example.com", "OWNER"}, {"a@example.com", "MEMBER"}},
+			want:    []member{{"a@example.com", "OWNER"}, {"a@example.com", "MEMBER"}, {"b@example.com", "MEMBER"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			sortByKey(test.members, func(m member) string { return m.email })
+			if !slices.Equal(test.members, test.want) {
+				t.Errorf("got %v, want %v", test.members, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &SuspendUserAction{}
+var _ action.ActionWithConfigure = &SuspendUserAction{}
+
+func NewSuspendUserAction() action.Action {
+	return &SuspendUserAction{}
+}
+
+// SuspendUserAction defines the action implementation.
+type SuspendUserAction struct {
+	client *http.Client
+
+	adminService *admin.Service
+}
+
+// SuspendUserActionModel describes the action data model.
+type SuspendUserActionModel struct {
+	UserKey   types.String `tfsdk:"user_key"`
+	Suspended types.Bool   `tfsdk:"suspended"`
+}
+
+func (a *SuspendUserAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_suspend_user"
+}
+
+func (a *SuspendUserAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Suspends or unsuspends a user without managing the user itself. Only
+		the suspension status is changed, a user already in the requested state is left untouched.`,
+
+		Attributes: map[string]schema.Attribute{
+			"user_key": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address, alias email address, or unique user ID",
+				Required:            true,
+			},
+			"suspended": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user should be suspended. Defaults to true.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *SuspendUserAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = pd.client
+	a.adminService = pd.adminService
+}
+
+func (a *SuspendUserAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data SuspendUserActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userKey := data.UserKey.ValueString()
+	suspended := data.Suspended.IsNull() || data.Suspended.ValueBool()
+
+	user, err := a.adminService.Users.Get(userKey).Fields("primaryEmail", "suspended").Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read user '%s', got error: %s", userKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	if user.Suspended != suspended {
+		// Only send the suspension status, ForceSendFields is needed to send
+		// false when unsuspending.
+		user, err = a.adminService.Users.Update(userKey, &admin.User{
+			Suspended:       suspended,
+			ForceSendFields: []string{"Suspended"},
+		}).Fields("primaryEmail", "suspended").Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating User",
+				fmt.Sprintf("Could not set suspended to %t for user %s: %v", suspended, userKey, formatAPIError(err)),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Set user suspension status", map[string]interface{}{
+		"user_key":  userKey,
+		"suspended": user.Suspended,
+	})
+
+	status := "active"
+	if user.Suspended {
+		status = "suspended"
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("User %s is %s", user.PrimaryEmail, status),
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultRequestTimeout = 30 * time.Second
+
+// timeoutTransport bounds every request it sends, including reading the
+// response body, so that a stuck request fails instead of blocking the apply.
+// Wrapped by the retry transport, each attempt gets its own deadline.
+type timeoutTransport struct {
+	base http.RoundTripper
+
+	timeout time.Duration
+}
+
+// newTimeoutTransport wraps base so that requests time out after timeout. A
+// timeout of zero disables the deadline.
+func newTimeoutTransport(base http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if timeout <= 0 {
+		return base
+	}
+
+	return &timeoutTransport{
+		base:    base,
+		timeout: timeout,
+	}
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		// Only blame the request timeout, not a deadline of the caller.
+		if errors.Is(err, context.DeadlineExceeded) && req.Context().Err() == nil {
+			return nil, fmt.Errorf("request timed out after %s: %w", t.timeout, err)
+		}
+		return nil, err
+	}
+
+	// The deadline has to outlive RoundTrip since the caller still reads the
+	// body, release it once the body is closed.
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
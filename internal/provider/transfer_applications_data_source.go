@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	datatransfer "google.golang.org/api/admin/datatransfer/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TransferApplicationsDataSource{}
+
+func NewTransferApplicationsDataSource() datasource.DataSource {
+	return &TransferApplicationsDataSource{}
+}
+
+// TransferApplicationsDataSource defines the data source implementation.
+type TransferApplicationsDataSource struct {
+	client     *http.Client
+	customerId string
+
+	datatransferService *datatransfer.Service
+}
+
+// TransferApplicationsDataSourceModel describes the data source data model.
+type TransferApplicationsDataSourceModel struct {
+	Customer     types.String                                `tfsdk:"customer"`
+	Applications []TransferApplicationsDataSourceApplication `tfsdk:"applications"`
+	Id           types.String                                `tfsdk:"id"`
+}
+
+// Nested Model for "applications".
+type TransferApplicationsDataSourceApplication struct {
+	Id             types.Int64                                   `tfsdk:"id"`
+	Name           types.String                                  `tfsdk:"name"`
+	TransferParams []TransferApplicationsDataSourceTransferParam `tfsdk:"transfer_params"`
+}
+
+// Nested Model for "applications.transfer_params".
+type TransferApplicationsDataSourceTransferParam struct {
+	Key    types.String   `tfsdk:"key"`
+	Values []types.String `tfsdk:"values"`
+}
+
+func (d *TransferApplicationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transfer_applications"
+}
+
+func (d *TransferApplicationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Lists the applications whose data can be transferred between users, with
+		their transfer parameters, for use in googleworkspace_data_transfer` + scopesDescription(datatransfer.AdminDatatransferScope),
+
+		Attributes: map[string]schema.Attribute{
+			"customer": schema.StringAttribute{
+				MarkdownDescription: `The unique ID for the customer's Google Workspace account. Defaults to
+				the provider customer_id.`,
+				Optional: true,
+				Computed: true,
+			},
+			"applications": schema.ListNestedAttribute{
+				MarkdownDescription: "The applications supporting data transfers, sorted by id",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Application identifier, referenced as application_id in transfers",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The application's name, e.g. 'Drive and Docs'",
+							Computed:            true,
+						},
+						"transfer_params": schema.ListNestedAttribute{
+							MarkdownDescription: "The transfer parameters the application accepts",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										MarkdownDescription: "The parameter key, e.g. 'PRIVACY_LEVEL'",
+										Computed:            true,
+									},
+									"values": schema.ListAttribute{
+										MarkdownDescription: "The values the parameter accepts",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TransferApplicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.datatransferService = pd.datatransferService
+}
+
+func (d *TransferApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TransferApplicationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer := d.customerId
+	if !data.Customer.IsNull() && !data.Customer.IsUnknown() {
+		customer = data.Customer.ValueString()
+	}
+
+	// The Data Transfer API only accepts immutable customer IDs and falls
+	// back to the customer of the caller when none is given.
+	call := d.datatransferService.Applications.List()
+	if customer != defaultCustomerId {
+		call = call.CustomerId(customer)
+	}
+
+	data.Applications = []TransferApplicationsDataSourceApplication{}
+
+	err := call.Pages(ctx, func(page *datatransfer.ApplicationsListResponse) error {
+		for _, a := range page.Applications {
+			data.Applications = append(data.Applications, flattenTransferApplication(a))
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list transfer applications for '%s', got error: %s", customer, formatAPIError(err)),
+		)
+		return
+	}
+
+	sortByKey(data.Applications, func(a TransferApplicationsDataSourceApplication) int64 { return a.Id.ValueInt64() })
+
+	data.Customer = types.StringValue(customer)
+	data.Id = types.StringValue(customer)
+
+	tflog.Trace(ctx, "read transfer applications", map[string]interface{}{
+		"customer": customer,
+		"count":    len(data.Applications),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenTransferApplication(a *datatransfer.Application) TransferApplicationsDataSourceApplication {
+	app := TransferApplicationsDataSourceApplication{
+		Id:             types.Int64Value(a.Id),
+		Name:           types.StringValue(a.Name),
+		TransferParams: make([]TransferApplicationsDataSourceTransferParam, 0, len(a.TransferParams)),
+	}
+
+	for _, p := range a.TransferParams {
+		param := TransferApplicationsDataSourceTransferParam{
+			Key:    types.StringValue(p.Key),
+			Values: make([]types.String, 0, len(p.Value)),
+		}
+		for _, v := range p.Value {
+			param.Values = append(param.Values, types.StringValue(v))
+		}
+		app.TransferParams = append(app.TransferParams, param)
+	}
+
+	return app
+}
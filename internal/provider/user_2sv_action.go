@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &User2svAction{}
+var _ action.ActionWithConfigure = &User2svAction{}
+
+func NewUser2svAction() action.Action {
+	return &User2svAction{}
+}
+
+// User2svAction defines the action implementation.
+type User2svAction struct {
+	client *http.Client
+
+	adminService *admin.Service
+}
+
+// User2svActionModel describes the action data model.
+type User2svActionModel struct {
+	UserKey types.String `tfsdk:"user_key"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (a *User2svAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_2sv"
+}
+
+func (a *User2svAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Brings a user's 2-Step Verification in the requested state. The Directory
+		API can only turn 2-Step Verification off, e.g. for a user who lost their second
+		factor. Users have to enroll themselves, and enforcement is configured per org unit
+		in the Admin console, so enabling only succeeds for users who are already enrolled.
+		Turning it off fails while 2-Step Verification is enforced for the user.` + scopesDescription(admin.AdminDirectoryUserSecurityScope),
+
+		Attributes: map[string]schema.Attribute{
+			"user_key": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address, alias email address, or unique user ID",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user should be enrolled in 2-Step Verification",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *User2svAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = pd.client
+	a.adminService = pd.adminService
+}
+
+func (a *User2svAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data User2svActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userKey := data.UserKey.ValueString()
+
+	u, err := a.adminService.Users.Get(userKey).Fields("isEnrolledIn2Sv", "isEnforcedIn2Sv").Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"User Not Found",
+				fmt.Sprintf("User %s does not exist in Google Workspace.", userKey),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read user '%s', got error: %s", userKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	if data.Enabled.ValueBool() {
+		if !u.IsEnrolledIn2Sv {
+			resp.Diagnostics.AddError(
+				"2-Step Verification Cannot Be Enabled",
+				fmt.Sprintf("User %s is not enrolled in 2-Step Verification. Google does not allow enrolling users "+
+					"through the API, the user has to enroll themselves. Enforce 2-Step Verification for the "+
+					"user's org unit in the Admin console to require it.", userKey),
+			)
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("User %s is already enrolled in 2-Step Verification", userKey),
+		})
+		return
+	}
+
+	if !u.IsEnrolledIn2Sv {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("User %s is not enrolled in 2-Step Verification", userKey),
+		})
+		return
+	}
+
+	err = a.adminService.TwoStepVerification.TurnOff(userKey).Context(ctx).Do()
+	if err != nil {
+		detail := fmt.Sprintf("Could not turn off 2-Step Verification for user %s: %v", userKey, formatAPIError(err))
+		if u.IsEnforcedIn2Sv {
+			detail += "\n\n2-Step Verification is enforced for the user. Exclude the user from enforcement, " +
+				"e.g. by moving them to an org unit or group without it, before turning it off."
+		}
+		resp.Diagnostics.AddError("Error Turning Off 2-Step Verification", detail)
+		return
+	}
+
+	tflog.Trace(ctx, "Turned off 2-Step Verification", map[string]interface{}{
+		"user_key": userKey,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Turned off 2-Step Verification for user %s", userKey),
+	})
+}
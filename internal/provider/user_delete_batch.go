@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	// userDeleteBatchWindow is how long the first delete of a batch waits
+	// for deletes of other resources to join it.
+	userDeleteBatchWindow = 250 * time.Millisecond
+
+	// userDeleteBatchSize bounds the number of deletes sent in one batch
+	// request, well below the 1000 the Directory API accepts so that a batch
+	// doesn't exhaust the rate limit by itself.
+	userDeleteBatchSize = 50
+)
+
+// userDeleteBatcher coalesces the user deletes that Terraform runs
+// concurrently, e.g. when destroying many googleworkspace_user resources at
+// once, into requests to the batch endpoint of the Directory API. Every
+// delete still reports its own result.
+type userDeleteBatcher struct {
+	client       *http.Client
+	adminService *admin.Service
+
+	window time.Duration
+	size   int
+
+	mu      sync.Mutex
+	pending []*userDelete
+}
+
+// userDelete is a delete waiting for its batch to be sent.
+type userDelete struct {
+	userKey string
+	err     chan error
+}
+
+// newUserDeleteBatcher returns a batcher sending its batch requests with
+// client to the endpoint of adminService, which sends deletes that are not
+// batched.
+func newUserDeleteBatcher(client *http.Client, adminService *admin.Service) *userDeleteBatcher {
+	return &userDeleteBatcher{
+		client:       client,
+		adminService: adminService,
+		window:       userDeleteBatchWindow,
+		size:         userDeleteBatchSize,
+	}
+}
+
+// Delete deletes the user with the given key, the primary email or id, once
+// the batch it joined has been sent.
+func (b *userDeleteBatcher) Delete(ctx context.Context, userKey string) error {
+	d := &userDelete{userKey: userKey, err: make(chan error, 1)}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, d)
+	switch {
+	case len(b.pending) >= b.size:
+		batch := b.pending
+		b.pending = nil
+		go b.send(batch)
+	case len(b.pending) == 1:
+		time.AfterFunc(b.window, b.flush)
+	}
+	b.mu.Unlock()
+
+	select {
+	case err := <-d.err:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flush sends the pending deletes, if any.
+func (b *userDeleteBatcher) flush() {
+	b.mu.Lock()
+	batch := b.pending
+	b.pending = nil
+	b.mu.Unlock()
+
+	if len(batch) > 0 {
+		b.send(batch)
+	}
+}
+
+// send deletes the users of batch and reports every result to its waiting
+// caller. The batch is shared by several resources, so it isn't cancelled
+// with the context of any of them. Deletes that failed on a rate limit or a
+// transient error are retried on their own, through the retry transport.
+func (b *userDeleteBatcher) send(batch []*userDelete) {
+	ctx := context.Background()
+
+	if len(batch) == 1 {
+		batch[0].err <- b.deleteUser(ctx, batch[0].userKey)
+		return
+	}
+
+	responses, err := b.sendBatch(ctx, batch)
+	for i, d := range batch {
+		if err != nil {
+			d.err <- err
+			continue
+		}
+
+		res := responses[i]
+		if res == nil {
+			d.err <- fmt.Errorf("batch response has no result for user %s", d.userKey)
+			continue
+		}
+		if isRetryableResponse(http.MethodDelete, res) {
+			d.err <- b.deleteUser(ctx, d.userKey)
+			continue
+		}
+		d.err <- googleapi.CheckResponse(res)
+	}
+}
+
+func (b *userDeleteBatcher) deleteUser(ctx context.Context, userKey string) error {
+	return b.adminService.Users.Delete(userKey).Context(ctx).Do()
+}
+
+// sendBatch sends the deletes of batch in a single batch request and returns
+// the response to every delete, in the order of batch.
+func (b *userDeleteBatcher) sendBatch(ctx context.Context, batch []*userDelete) ([]*http.Response, error) {
+	base, err := url.Parse(b.adminService.BasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for i, d := range batch {
+		part, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type": {"application/http"},
+			"Content-Id":   {fmt.Sprintf("<item%d>", i)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(part, "DELETE %sadmin/directory/v1/users/%s HTTP/1.1\r\n\r\n", base.Path, url.PathEscape(d.userKey))
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.adminService.BasePath+"batch/admin/directory_v1", bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "multipart/mixed; boundary="+w.Boundary())
+
+	tflog.Debug(ctx, "Deleting Google users in a batch", map[string]interface{}{
+		"count": len(batch),
+	})
+
+	res, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if err := googleapi.CheckResponse(res); err != nil {
+		return nil, err
+	}
+
+	return splitBatchResponse(res.Header.Get("Content-Type"), res.Body, len(batch))
+}
+
+// splitBatchResponse splits the multipart response to a batch request of n
+// requests into the response to every request. A request's response is
+// matched by the Content-ID of its part, "<response-itemN>" for the request
+// sent as "<itemN>", since Google doesn't guarantee the order of the parts.
+// Requests without a part have a nil response.
+func splitBatchResponse(contentType string, body io.Reader, n int) ([]*http.Response, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch response content type %q: %w", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, fmt.Errorf("batch response has content type %s, want a multipart response", mediaType)
+	}
+
+	responses := make([]*http.Response, n)
+	r := multipart.NewReader(body, params["boundary"])
+	for {
+		part, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		id := strings.Trim(part.Header.Get("Content-Id"), "<>")
+		i, err := strconv.Atoi(strings.TrimPrefix(id, "response-item"))
+		if err != nil || i < 0 || i >= n {
+			return nil, fmt.Errorf("batch response has a part with unknown Content-ID %q", id)
+		}
+
+		res, err := http.ReadResponse(bufio.NewReader(part), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// The part is only readable until the next one, keep the body.
+		resBody, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+		responses[i] = res
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	admin "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+// testBatchResponse writes a batch response with a part per Content-ID
+// holding the HTTP response in responses, and returns its content type.
+func testBatchResponse(t *testing.T, w io.Writer, responses map[string]string) string {
+	t.Helper()
+
+	mw := multipart.NewWriter(w)
+	for id, res := range responses {
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type": {"application/http"},
+			"Content-Id":   {"<" + id + ">"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = io.WriteString(part, res)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return "multipart/mixed; boundary=" + mw.Boundary()
+}
+
+const (
+	testBatchNoContent = "HTTP/1.1 204 No Content\r\n\r\n"
+	testBatchNotFound  = "HTTP/1.1 404 Not Found\r\nContent-Type: application/json\r\n\r\n" +
+		`{"error":{"code":404,"message":"Resource Not Found: userKey"}}`
+	testBatchUnavailable = "HTTP/1.1 503 Service Unavailable\r\nContent-Type: application/json\r\n\r\n" +
+		`{"error":{"code":503,"message":"The service is currently unavailable."}}`
+)
+
+func TestSplitBatchResponse(t *testing.T) {
+	tests := map[string]struct {
+		responses   map[string]string
+		contentType string
+		n           int
+		wantStatus  []int
+		wantErr     bool
+	}{
+		"success and not found": {
+			responses: map[string]string{
+				"response-item1": testBatchNotFound,
+				"response-item0": testBatchNoContent,
+				"response-item2": testBatchNoContent,
+			},
+			n:          3,
+			wantStatus: []int{http.StatusNoContent, http.StatusNotFound, http.StatusNoContent},
+		},
+		"missing part": {
+			responses: map[string]string{
+				"response-item0": testBatchNotFound,
+			},
+			n:          2,
+			wantStatus: []int{http.StatusNotFound, 0},
+		},
+		"unknown part": {
+			responses: map[string]string{
+				"response-item2": testBatchNoContent,
+			},
+			n:       2,
+			wantErr: true,
+		},
+		"not multipart": {
+			contentType: "application/json",
+			n:           1,
+			wantErr:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var body bytes.Buffer
+			contentType := test.contentType
+			if contentType == "" {
+				contentType = testBatchResponse(t, &body, test.responses)
+			}
+
+			responses, err := splitBatchResponse(contentType, &body, test.n)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("got no error, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for i, res := range responses {
+				status := 0
+				if res != nil {
+					status = res.StatusCode
+				}
+				if status != test.wantStatus[i] {
+					t.Errorf("got status %d for item %d, want %d", status, i, test.wantStatus[i])
+				}
+			}
+
+			if res := responses[0]; res != nil && res.StatusCode == http.StatusNotFound {
+				if err := googleapi.CheckResponse(res); !isNotFound(err) {
+					t.Errorf("got error %v, want a 404", err)
+				}
+			}
+		})
+	}
+}
+
+func TestUserDeleteBatcher(t *testing.T) {
+	var mu sync.Mutex
+	var batches, deletes []string
+
+	// Users whose deletes fail with a transient error in a batch succeed when
+	// retried on their own.
+	results := map[string]string{
+		"deleted@example.com":     testBatchNoContent,
+		"gone@example.com":        testBatchNotFound,
+		"unavailable@example.com": testBatchUnavailable,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/batch/admin/directory_v1":
+			_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil {
+				t.Errorf("unexpected error parsing the content type: %s", err)
+			}
+
+			responses := map[string]string{}
+			mr := multipart.NewReader(r.Body, params["boundary"])
+			for {
+				part, err := mr.NextPart()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Errorf("unexpected error reading the batch: %s", err)
+					return
+				}
+
+				req, err := http.ReadRequest(bufio.NewReader(part))
+				if err != nil {
+					t.Errorf("unexpected error reading the batched request: %s", err)
+					return
+				}
+				email := strings.TrimPrefix(req.URL.Path, "/admin/directory/v1/users/")
+				batches = append(batches, req.Method+" "+email)
+
+				id := strings.Trim(part.Header.Get("Content-Id"), "<>")
+				responses["response-"+id] = results[email]
+			}
+
+			var body bytes.Buffer
+			w.Header().Set("Content-Type", testBatchResponse(t, &body, responses))
+			_, _ = w.Write(body.Bytes())
+		case r.Method == http.MethodDelete:
+			deletes = append(deletes, strings.TrimPrefix(r.URL.Path, "/admin/directory/v1/users/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	adminService, err := admin.NewService(context.Background(), option.WithEndpoint(server.URL+"/"), option.WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		window      time.Duration
+		size        int
+		wantBatched int
+	}{
+		"within the window": {
+			window:      100 * time.Millisecond,
+			size:        userDeleteBatchSize,
+			wantBatched: 3,
+		},
+		"full batch is sent right away": {
+			window:      time.Hour,
+			size:        3,
+			wantBatched: 3,
+		},
+		"batch of one": {
+			window: 100 * time.Millisecond,
+			size:   1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			batches, deletes = nil, nil
+
+			b := newUserDeleteBatcher(server.Client(), adminService)
+			b.window = test.window
+			b.size = test.size
+
+			errs := map[string]error{}
+			var wg sync.WaitGroup
+			for email := range results {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					err := b.Delete(context.Background(), email)
+					mu.Lock()
+					errs[email] = err
+					mu.Unlock()
+				}()
+			}
+			wg.Wait()
+
+			if err := errs["deleted@example.com"]; err != nil {
+				t.Errorf("unexpected error deleting a user: %s", err)
+			}
+			if err := errs["unavailable@example.com"]; err != nil {
+				t.Errorf("unexpected error deleting a user after a transient error: %s", err)
+			}
+			if err := errs["gone@example.com"]; test.wantBatched > 0 && !isNotFound(err) {
+				t.Errorf("got error %v deleting a deleted user, want a 404", err)
+			}
+
+			if len(batches) != test.wantBatched {
+				t.Errorf("got batched requests %v, want %d", batches, test.wantBatched)
+			}
+			wantDeletes := 3 - test.wantBatched
+			if test.wantBatched > 0 {
+				// The user that was unavailable is deleted on its own.
+				wantDeletes = 1
+			}
+			if len(deletes) != wantDeletes {
+				t.Errorf("got deletes %v, want %d", deletes, wantDeletes)
+			}
+		})
+	}
+}
+
+func TestUserDeleteBatcherBatchFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprint(w, `{"error":{"code":400,"message":"Invalid batch request"}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	adminService, err := admin.NewService(context.Background(), option.WithEndpoint(server.URL+"/"), option.WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := newUserDeleteBatcher(server.Client(), adminService)
+	b.size = 2
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = b.Delete(context.Background(), fmt.Sprintf("user%d@example.com", i))
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err == nil || !strings.Contains(err.Error(), "Invalid batch request") {
+			t.Errorf("got error %v for delete %d, want the error of the batch", err, i)
+		}
+	}
+}
@@ -0,0 +1,1964 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	client *http.Client
+
+	adminService      *admin.Service
+	userDeleteBatcher *userDeleteBatcher
+
+	readRetryTimeout time.Duration
+}
+
+// UserResourceModel describes the resource data model.
+type UserResourceModel struct {
+	PrimaryEmail               types.String   `tfsdk:"primary_email"`
+	Password                   types.String   `tfsdk:"password"`
+	PasswordVersion            types.Int64    `tfsdk:"password_version"`
+	HashFunction               types.String   `tfsdk:"hash_function"`
+	Name                       *UserNameModel `tfsdk:"name"`
+	OrgUnitPath                types.String   `tfsdk:"org_unit_path"`
+	Suspended                  types.Bool     `tfsdk:"suspended"`
+	ChangePasswordAtNextLogin  types.Bool     `tfsdk:"change_password_at_next_login"`
+	IsAdmin                    types.Bool     `tfsdk:"is_admin"`
+	IncludeInGlobalAddressList types.Bool     `tfsdk:"include_in_global_address_list"`
+	IsMailboxSetup             types.Bool     `tfsdk:"is_mailbox_setup"`
+	CreationTime               types.String   `tfsdk:"creation_time"`
+	Emails                     types.Set      `tfsdk:"emails"`
+	Phones                     types.Set      `tfsdk:"phones"`
+	WorkPhone                  types.String   `tfsdk:"work_phone"`
+	Addresses                  types.Set      `tfsdk:"addresses"`
+	Organizations              types.Set      `tfsdk:"organizations"`
+	Relations                  types.Set      `tfsdk:"relations"`
+	PosixAccounts              types.Set      `tfsdk:"posix_accounts"`
+	SshPublicKeys              types.Set      `tfsdk:"ssh_public_keys"`
+	Languages                  types.Set      `tfsdk:"languages"`
+	Locations                  types.Set      `tfsdk:"locations"`
+	ExternalIds                types.Set      `tfsdk:"external_ids"`
+	Keywords                   types.Set      `tfsdk:"keywords"`
+	Etag                       types.String   `tfsdk:"etag"`
+	Id                         types.String   `tfsdk:"id"`
+}
+
+// Nested Model for "name".
+type UserNameModel struct {
+	GivenName  types.String `tfsdk:"given_name"`
+	FamilyName types.String `tfsdk:"family_name"`
+}
+
+// Nested Model for "emails".
+type UserEmailModel struct {
+	Address    types.String `tfsdk:"address"`
+	Type       types.String `tfsdk:"type"`
+	CustomType types.String `tfsdk:"custom_type"`
+	Primary    types.Bool   `tfsdk:"primary"`
+}
+
+// Nested Model for "phones".
+type UserPhoneModel struct {
+	Value      types.String `tfsdk:"value"`
+	Type       types.String `tfsdk:"type"`
+	CustomType types.String `tfsdk:"custom_type"`
+	Primary    types.Bool   `tfsdk:"primary"`
+}
+
+// Nested Model for "addresses".
+type UserAddressModel struct {
+	Type            types.String `tfsdk:"type"`
+	CustomType      types.String `tfsdk:"custom_type"`
+	Primary         types.Bool   `tfsdk:"primary"`
+	Formatted       types.String `tfsdk:"formatted"`
+	StreetAddress   types.String `tfsdk:"street_address"`
+	ExtendedAddress types.String `tfsdk:"extended_address"`
+	PoBox           types.String `tfsdk:"po_box"`
+	Locality        types.String `tfsdk:"locality"`
+	Region          types.String `tfsdk:"region"`
+	PostalCode      types.String `tfsdk:"postal_code"`
+	Country         types.String `tfsdk:"country"`
+	CountryCode     types.String `tfsdk:"country_code"`
+}
+
+// Nested Model for "organizations".
+type UserOrganizationModel struct {
+	Name               types.String `tfsdk:"name"`
+	Title              types.String `tfsdk:"title"`
+	Department         types.String `tfsdk:"department"`
+	CostCenter         types.String `tfsdk:"cost_center"`
+	Description        types.String `tfsdk:"description"`
+	Location           types.String `tfsdk:"location"`
+	Domain             types.String `tfsdk:"domain"`
+	Symbol             types.String `tfsdk:"symbol"`
+	FullTimeEquivalent types.Int64  `tfsdk:"full_time_equivalent"`
+	Type               types.String `tfsdk:"type"`
+	CustomType         types.String `tfsdk:"custom_type"`
+	Primary            types.Bool   `tfsdk:"primary"`
+}
+
+// Nested Model for "relations".
+type UserRelationModel struct {
+	Value      types.String `tfsdk:"value"`
+	Type       types.String `tfsdk:"type"`
+	CustomType types.String `tfsdk:"custom_type"`
+}
+
+// Nested Model for "posix_accounts".
+type UserPosixAccountModel struct {
+	Username      types.String `tfsdk:"username"`
+	Uid           types.Int64  `tfsdk:"uid"`
+	Gid           types.Int64  `tfsdk:"gid"`
+	HomeDirectory types.String `tfsdk:"home_directory"`
+	Shell         types.String `tfsdk:"shell"`
+	SystemId      types.String `tfsdk:"system_id"`
+}
+
+// Nested Model for "ssh_public_keys".
+type UserSshPublicKeyModel struct {
+	Key                types.String `tfsdk:"key"`
+	ExpirationTimeUsec types.Int64  `tfsdk:"expiration_time_usec"`
+}
+
+// Nested Model for "languages".
+type UserLanguageModel struct {
+	LanguageCode   types.String `tfsdk:"language_code"`
+	CustomLanguage types.String `tfsdk:"custom_language"`
+	Preference     types.String `tfsdk:"preference"`
+}
+
+// Nested Model for "locations".
+type UserLocationModel struct {
+	Type         types.String `tfsdk:"type"`
+	CustomType   types.String `tfsdk:"custom_type"`
+	Area         types.String `tfsdk:"area"`
+	BuildingId   types.String `tfsdk:"building_id"`
+	FloorName    types.String `tfsdk:"floor_name"`
+	FloorSection types.String `tfsdk:"floor_section"`
+	DeskCode     types.String `tfsdk:"desk_code"`
+}
+
+// Nested Model for "external_ids".
+type UserExternalIdModel struct {
+	Value      types.String `tfsdk:"value"`
+	Type       types.String `tfsdk:"type"`
+	CustomType types.String `tfsdk:"custom_type"`
+}
+
+// Nested Model for "keywords".
+type UserKeywordModel struct {
+	Value      types.String `tfsdk:"value"`
+	Type       types.String `tfsdk:"type"`
+	CustomType types.String `tfsdk:"custom_type"`
+}
+
+var userEmailAttrTypes = map[string]attr.Type{
+	"address":     types.StringType,
+	"type":        types.StringType,
+	"custom_type": types.StringType,
+	"primary":     types.BoolType,
+}
+
+var userPhoneAttrTypes = map[string]attr.Type{
+	"value":       types.StringType,
+	"type":        types.StringType,
+	"custom_type": types.StringType,
+	"primary":     types.BoolType,
+}
+
+var userAddressAttrTypes = map[string]attr.Type{
+	"type":             types.StringType,
+	"custom_type":      types.StringType,
+	"primary":          types.BoolType,
+	"formatted":        types.StringType,
+	"street_address":   types.StringType,
+	"extended_address": types.StringType,
+	"po_box":           types.StringType,
+	"locality":         types.StringType,
+	"region":           types.StringType,
+	"postal_code":      types.StringType,
+	"country":          types.StringType,
+	"country_code":     types.StringType,
+}
+
+var userOrganizationAttrTypes = map[string]attr.Type{
+	"name":                 types.StringType,
+	"title":                types.StringType,
+	"department":           types.StringType,
+	"cost_center":          types.StringType,
+	"description":          types.StringType,
+	"location":             types.StringType,
+	"domain":               types.StringType,
+	"symbol":               types.StringType,
+	"full_time_equivalent": types.Int64Type,
+	"type":                 types.StringType,
+	"custom_type":          types.StringType,
+	"primary":              types.BoolType,
+}
+
+var userRelationAttrTypes = map[string]attr.Type{
+	"value":       types.StringType,
+	"type":        types.StringType,
+	"custom_type": types.StringType,
+}
+
+var userPosixAccountAttrTypes = map[string]attr.Type{
+	"username":       types.StringType,
+	"uid":            types.Int64Type,
+	"gid":            types.Int64Type,
+	"home_directory": types.StringType,
+	"shell":          types.StringType,
+	"system_id":      types.StringType,
+}
+
+var userSshPublicKeyAttrTypes = map[string]attr.Type{
+	"key":                  types.StringType,
+	"expiration_time_usec": types.Int64Type,
+}
+
+var userLanguageAttrTypes = map[string]attr.Type{
+	"language_code":   types.StringType,
+	"custom_language": types.StringType,
+	"preference":      types.StringType,
+}
+
+var userLocationAttrTypes = map[string]attr.Type{
+	"type":          types.StringType,
+	"custom_type":   types.StringType,
+	"area":          types.StringType,
+	"building_id":   types.StringType,
+	"floor_name":    types.StringType,
+	"floor_section": types.StringType,
+	"desk_code":     types.StringType,
+}
+
+var userExternalIdAttrTypes = map[string]attr.Type{
+	"value":       types.StringType,
+	"type":        types.StringType,
+	"custom_type": types.StringType,
+}
+
+var userKeywordAttrTypes = map[string]attr.Type{
+	"value":       types.StringType,
+	"type":        types.StringType,
+	"custom_type": types.StringType,
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `User resource. Users that are destroyed together, e.g. with the module
+		managing them, are deleted in batch requests to stay clear of rate limits.`,
+
+		Attributes: map[string]schema.Attribute{
+			"primary_email": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: `The user's password, either plaintext or hashed with 'hash_function'.
+				Required to create a user. This is a write-only attribute: it is never stored in
+				state, so bump 'password_version' to send a new password to Google.`,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Changing this value sends the configured `password` to Google again, which must then be set",
+				Optional:            true,
+			},
+			"hash_function": schema.StringAttribute{
+				MarkdownDescription: `Hash function used to hash 'password', one of 'SHA-1', 'MD5' or 'crypt'.
+				A hashed password is sent to Google as is, e.g. when migrating users from another
+				system. Leave unset when 'password' is plaintext, Google then hashes it itself.`,
+				Optional: true,
+				Validators: []validator.String{
+					stringOneOf("SHA-1", "MD5", "crypt"),
+				},
+			},
+			"name": schema.SingleNestedAttribute{
+				MarkdownDescription: "The user's name",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"given_name": schema.StringAttribute{
+						MarkdownDescription: "The user's first name",
+						Required:            true,
+					},
+					"family_name": schema.StringAttribute{
+						MarkdownDescription: "The user's last name",
+						Required:            true,
+					},
+				},
+			},
+			"org_unit_path": schema.StringAttribute{
+				MarkdownDescription: "The full path of the parent organization associated with the user. Defaults to '/'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("/"),
+			},
+			"suspended": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is suspended",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"change_password_at_next_login": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is forced to change their password at next login",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has super admin privileges",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"include_in_global_address_list": schema.BoolAttribute{
+				MarkdownDescription: `Whether the user is listed in the global address list. Set to false to
+				hide e.g. role accounts from the directory. Defaults to true.`,
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"is_mailbox_setup": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user's Google mailbox is created",
+				Computed:            true,
+			},
+			"creation_time": schema.StringAttribute{
+				MarkdownDescription: "The time the user's account was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emails": schema.SetNestedAttribute{
+				MarkdownDescription: `Additional email addresses of the user. Google lists the primary
+				address and aliases here as well, they are ignored unless configured. Leave unset
+				to not manage the user's email addresses.`,
+				Optional: true,
+				Validators: []validator.Set{
+					setObjectsUniqueKey("address"),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The email address",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the address, custom, home, other or work. Defaults to work.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("work"),
+							Validators: []validator.String{
+								stringOneOf("custom", "home", "other", "work"),
+							},
+						},
+						"custom_type": schema.StringAttribute{
+							MarkdownDescription: "Name of the type when type is custom",
+							Optional:            true,
+						},
+						"primary": schema.BoolAttribute{
+							MarkdownDescription: "Whether this is the user's primary email address. Defaults to false.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"phones": schema.SetNestedAttribute{
+				MarkdownDescription: "Phone numbers of the user. Leave unset to not manage the user's phone numbers.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The phone number, e.g. '+31 10 123 4567'",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the phone number, e.g. mobile, work or custom. Defaults to work.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("work"),
+							Validators: []validator.String{
+								stringOneOf("assistant", "callback", "car", "company_main", "custom", "grand_central",
+									"home", "home_fax", "isdn", "main", "mobile", "other", "other_fax", "pager", "radio",
+									"telex", "tty_tdd", "work", "work_fax", "work_mobile", "work_pager"),
+							},
+						},
+						"custom_type": schema.StringAttribute{
+							MarkdownDescription: "Name of the type when type is custom",
+							Optional:            true,
+						},
+						"primary": schema.BoolAttribute{
+							MarkdownDescription: "Whether this is the user's primary phone number. Defaults to false.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"work_phone": schema.StringAttribute{
+				MarkdownDescription: `The user's primary work phone number. Shorthand for a phones entry of type
+				work with primary set, which is then left out of phones. Conflicts with such an entry
+				in phones. Without phones the user's other phone numbers are left untouched.`,
+				Optional: true,
+			},
+			"addresses": schema.SetNestedAttribute{
+				MarkdownDescription: `Postal addresses of the user, either as a single formatted string or as
+				structured fields. Leave unset to not manage the user's addresses.`,
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the address, custom, home, other or work. Defaults to work.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("work"),
+							Validators: []validator.String{
+								stringOneOf("custom", "home", "other", "work"),
+							},
+						},
+						"custom_type": schema.StringAttribute{
+							MarkdownDescription: "Name of the type when type is custom",
+							Optional:            true,
+						},
+						"primary": schema.BoolAttribute{
+							MarkdownDescription: "Whether this is the user's primary address. Defaults to false.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"formatted": schema.StringAttribute{
+							MarkdownDescription: "The full, unstructured address. Leave unset when using the structured fields.",
+							Optional:            true,
+						},
+						"street_address": schema.StringAttribute{
+							MarkdownDescription: "The street address, e.g. '1600 Amphitheatre Parkway'",
+							Optional:            true,
+						},
+						"extended_address": schema.StringAttribute{
+							MarkdownDescription: "Additional address lines, e.g. a suite or floor",
+							Optional:            true,
+						},
+						"po_box": schema.StringAttribute{
+							MarkdownDescription: "The post office box",
+							Optional:            true,
+						},
+						"locality": schema.StringAttribute{
+							MarkdownDescription: "The town or city",
+							Optional:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The province or state",
+							Optional:            true,
+						},
+						"postal_code": schema.StringAttribute{
+							MarkdownDescription: "The ZIP or postal code",
+							Optional:            true,
+						},
+						"country": schema.StringAttribute{
+							MarkdownDescription: "The country",
+							Optional:            true,
+						},
+						"country_code": schema.StringAttribute{
+							MarkdownDescription: "The ISO 3166-1 alpha-2 country code",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"organizations": schema.SetNestedAttribute{
+				MarkdownDescription: "Organizations the user belongs to, e.g. their job title and department. Leave unset to not manage the user's organizations.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the organization",
+							Optional:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The user's title within the organization, e.g. 'Engineer'",
+							Optional:            true,
+						},
+						"department": schema.StringAttribute{
+							MarkdownDescription: "The department within the organization",
+							Optional:            true,
+						},
+						"cost_center": schema.StringAttribute{
+							MarkdownDescription: "The cost center of the user's organization",
+							Optional:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the organization",
+							Optional:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "The physical location of the organization",
+							Optional:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The domain the organization belongs to",
+							Optional:            true,
+						},
+						"symbol": schema.StringAttribute{
+							MarkdownDescription: "Text string symbol of the organization, e.g. its stock ticker",
+							Optional:            true,
+						},
+						"full_time_equivalent": schema.Int64Attribute{
+							MarkdownDescription: "The full-time equivalent millipercent within the organization, 100000 is full time",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64Between(0, 100000),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the organization, custom, domain_only, school, unknown or work. Defaults to work.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("work"),
+							Validators: []validator.String{
+								stringOneOf("custom", "domain_only", "school", "unknown", "work"),
+							},
+						},
+						"custom_type": schema.StringAttribute{
+							MarkdownDescription: "Name of the type when type is custom",
+							Optional:            true,
+						},
+						"primary": schema.BoolAttribute{
+							MarkdownDescription: "Whether this is the user's primary organization. Defaults to false.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"relations": schema.SetNestedAttribute{
+				MarkdownDescription: `People the user is related to, such as their manager. Leave unset to not
+				manage the user's relations.`,
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: `The related person. For a manager this is their email address, e.g.
+							the primary_email of another googleworkspace_user.`,
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the relation, e.g. manager, assistant or custom",
+							Required:            true,
+							Validators: []validator.String{
+								stringOneOf("admin_assistant", "assistant", "brother", "child", "custom", "domestic_partner",
+									"dotted_line_manager", "exec_assistant", "father", "friend", "manager", "mother", "parent",
+									"partner", "referred_by", "relative", "sister", "spouse"),
+							},
+						},
+						"custom_type": schema.StringAttribute{
+							MarkdownDescription: "Name of the type when type is custom",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"posix_accounts": schema.SetNestedAttribute{
+				MarkdownDescription: "POSIX accounts of the user. Leave unset to not manage the user's POSIX accounts.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The username of the account",
+							Required:            true,
+						},
+						"uid": schema.Int64Attribute{
+							MarkdownDescription: "The POSIX compliant user ID",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64Between(0, 4294967295),
+							},
+						},
+						"gid": schema.Int64Attribute{
+							MarkdownDescription: "The default group ID",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64Between(0, 4294967295),
+							},
+						},
+						"home_directory": schema.StringAttribute{
+							MarkdownDescription: "The path to the home directory, e.g. '/home/jane'",
+							Optional:            true,
+						},
+						"shell": schema.StringAttribute{
+							MarkdownDescription: "The path to the login shell, e.g. '/bin/bash'",
+							Optional:            true,
+						},
+						"system_id": schema.StringAttribute{
+							MarkdownDescription: "The system the account applies to, when the user has accounts on several systems",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"ssh_public_keys": schema.SetNestedAttribute{
+				MarkdownDescription: `SSH public keys of the user. Google removes keys once they expire, keys
+				that expired are kept in state as is and not sent again. Leave unset to not manage
+				the user's SSH keys.`,
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The SSH public key, e.g. 'ssh-ed25519 AAAA... jane@example.com'",
+							Required:            true,
+						},
+						"expiration_time_usec": schema.Int64Attribute{
+							MarkdownDescription: "When the key expires, in microseconds since the epoch. The key doesn't expire when unset.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"languages": schema.SetNestedAttribute{
+				MarkdownDescription: "Languages the user speaks. Leave unset to not manage the user's languages.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"language_code": schema.StringAttribute{
+							MarkdownDescription: "ISO 639 code of the language, e.g. 'en' or 'nl'. Conflicts with custom_language.",
+							Optional:            true,
+						},
+						"custom_language": schema.StringAttribute{
+							MarkdownDescription: "Name of a language without an ISO 639 code. Conflicts with language_code.",
+							Optional:            true,
+						},
+						"preference": schema.StringAttribute{
+							MarkdownDescription: "Whether this is the user's preferred language, preferred or not_preferred. Only valid with language_code.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringOneOf("preferred", "not_preferred"),
+							},
+						},
+					},
+				},
+			},
+			"locations": schema.SetNestedAttribute{
+				MarkdownDescription: "Where the user works. Leave unset to not manage the user's locations.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the location, custom, default or desk",
+							Required:            true,
+							Validators: []validator.String{
+								stringOneOf("custom", "default", "desk"),
+							},
+						},
+						"custom_type": schema.StringAttribute{
+							MarkdownDescription: "Name of the type when type is custom",
+							Optional:            true,
+						},
+						"area": schema.StringAttribute{
+							MarkdownDescription: "Textual description of the location, e.g. 'Amsterdam, NL'",
+							Optional:            true,
+						},
+						"building_id": schema.StringAttribute{
+							MarkdownDescription: "The building, e.g. the building_id of a googleworkspace_building",
+							Optional:            true,
+						},
+						"floor_name": schema.StringAttribute{
+							MarkdownDescription: "The floor, one of the floor_names of the building",
+							Optional:            true,
+						},
+						"floor_section": schema.StringAttribute{
+							MarkdownDescription: "The section of the floor, e.g. 'A'",
+							Optional:            true,
+						},
+						"desk_code": schema.StringAttribute{
+							MarkdownDescription: "The code of the user's desk",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"external_ids": schema.SetNestedAttribute{
+				MarkdownDescription: `Identifiers of the user in other systems, e.g. their employee number in
+				an HR system. Leave unset to not manage the user's external IDs.`,
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The ID",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the ID, account, custom, customer, login_id, network or organization",
+							Required:            true,
+							Validators: []validator.String{
+								stringOneOf("account", "custom", "customer", "login_id", "network", "organization"),
+							},
+						},
+						"custom_type": schema.StringAttribute{
+							MarkdownDescription: "Name of the type when type is custom",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"keywords": schema.SetNestedAttribute{
+				MarkdownDescription: "Keywords describing the user. Leave unset to not manage the user's keywords.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The keyword",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the keyword, custom, mission, occupation or outlook",
+							Required:            true,
+							Validators: []validator.String{
+								stringOneOf("custom", "mission", "occupation", "outlook"),
+							},
+						},
+						"custom_type": schema.StringAttribute{
+							MarkdownDescription: "Name of the type when type is custom",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"etag": schema.StringAttribute{
+				MarkdownDescription: "ETag of the user, changes whenever the user is modified",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "User identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects a work_phone next to a phones entry for the same
+// primary work phone, one of them would be silently dropped on apply.
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var workPhone types.String
+	var phones types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("work_phone"), &workPhone)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("phones"), &phones)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if workPhone.IsNull() || phones.IsNull() || phones.IsUnknown() {
+		return
+	}
+
+	var models []UserPhoneModel
+	resp.Diagnostics.Append(phones.ElementsAs(ctx, &models, false)...)
+
+	for _, m := range models {
+		// The type defaults to work, it is still null in the configuration.
+		if m.Type.IsUnknown() || m.Primary.IsUnknown() {
+			continue
+		}
+		if (m.Type.IsNull() || m.Type.ValueString() == "work") && m.Primary.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("work_phone"),
+				"Conflicting Work Phone",
+				"work_phone conflicts with the primary work phone in phones, set only one of them.",
+			)
+			return
+		}
+	}
+}
+
+// ModifyPlan requires password whenever password_version changes, since the
+// version only exists to send the password again and Update would otherwise
+// silently skip it.
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// A new user is checked by Create, nothing to check on destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(etagUnknownOnChange(ctx, req, resp)...)
+
+	var version, stateVersion types.Int64
+	var password types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("password_version"), &version)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("password_version"), &stateVersion)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() || version.IsNull() || version.IsUnknown() || version.Equal(stateVersion) {
+		return
+	}
+
+	if password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing User Password",
+			"password_version changed, but no password is configured to send to Google. Set password, or revert password_version.",
+		)
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.adminService = pd.adminService
+	r.userDeleteBatcher = pd.userDeleteBatcher
+	r.readRetryTimeout = pd.readRetryTimeout
+}
+
+func (r *UserResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data UserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only attributes are only available in the configuration.
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if password.IsNull() || password.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing User Password",
+			"A password is required to create a Google user.",
+		)
+		return
+	}
+
+	// The password is sent verbatim. Without a hash function Google treats
+	// it as plaintext and hashes it, otherwise it is stored as given.
+	nu, diags := r.expandUser(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	nu.Password = password.ValueString()
+	nu.HashFunction = data.HashFunction.ValueString()
+
+	res, err := r.adminService.Users.Insert(nu).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Google User",
+			fmt.Sprintf("Could not create user %s: %v", data.PrimaryEmail.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	// Save the user into Terraform state right away, so that it is tainted
+	// rather than orphaned when reading it back or changing its admin status
+	// fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), res.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("primary_email"), res.PrimaryEmail)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Directory API is eventually consistent, make sure the new user can
+	// be read back before handing it to dependent resources.
+	userId := res.Id
+	res, err = readWithRetry(ctx, r.readRetryTimeout, func(ctx context.Context) (*admin.User, error) {
+		return r.adminService.Users.Get(userId).Context(ctx).Do()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading created Google User",
+			fmt.Sprintf("User %s was created but could not be read back: %v", data.PrimaryEmail.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	if data.IsAdmin.ValueBool() != res.IsAdmin {
+		err := r.adminService.Users.MakeAdmin(res.Id, &admin.UserMakeAdmin{
+			Status:          data.IsAdmin.ValueBool(),
+			ForceSendFields: []string{"Status"},
+		}).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating Google User",
+				fmt.Sprintf("Could not change admin status of user %s: %v", res.PrimaryEmail, formatAPIError(err)),
+			)
+			return
+		}
+		res.IsAdmin = data.IsAdmin.ValueBool()
+	}
+
+	resp.Diagnostics.Append(r.flattenUser(ctx, res, &data)...)
+
+	tflog.Trace(ctx, "Created Google User", map[string]interface{}{
+		"id":    res.Id,
+		"email": res.PrimaryEmail,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Users.Get accepts both the numeric id and the primary email, the latter
+	// is used right after an import by email.
+	u, err := r.adminService.Users.Get(data.Id.ValueString()).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "User no longer exists in Google Workspace, removing from state", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read user '%s', got error: %s", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.flattenUser(ctx, u, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Write-only attributes are only available in the configuration.
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the changed attributes are sent, so that attributes of the user
+	// managed elsewhere are not overwritten.
+	uu, diags := r.expandUserPatch(ctx, &data, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// work_phone without phones only replaces the primary work phone, the
+	// user's other phone numbers are read back and kept.
+	if data.Phones.IsNull() && !data.WorkPhone.Equal(state.WorkPhone) {
+		current, err := r.adminService.Users.Get(data.Id.ValueString()).Fields("phones").Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Google User",
+				fmt.Sprintf("Could not read the phone numbers of user ID %s: %v", data.Id.ValueString(), formatAPIError(err)),
+			)
+			return
+		}
+
+		var phones []admin.UserPhone
+		decodeUserList(current.Phones, &phones, "phones", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		uu.Phones = withWorkPhone(phones, data.WorkPhone.ValueString())
+	}
+
+	// The password is only sent again when its version changes, since it is
+	// not stored in state and can't be compared.
+	if !data.PasswordVersion.Equal(state.PasswordVersion) && !password.IsNull() {
+		uu.Password = password.ValueString()
+		uu.HashFunction = data.HashFunction.ValueString()
+	}
+
+	res, err := r.adminService.Users.Patch(data.Id.ValueString(), uu).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Google User",
+			fmt.Sprintf("Could not update user ID %s: %v", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	if data.IsAdmin.ValueBool() != state.IsAdmin.ValueBool() {
+		err := r.adminService.Users.MakeAdmin(res.Id, &admin.UserMakeAdmin{
+			Status:          data.IsAdmin.ValueBool(),
+			ForceSendFields: []string{"Status"},
+		}).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Google User",
+				fmt.Sprintf("Could not change admin status of user ID %s: %v", data.Id.ValueString(), formatAPIError(err)),
+			)
+			return
+		}
+	}
+	res.IsAdmin = data.IsAdmin.ValueBool()
+
+	resp.Diagnostics.Append(r.flattenUser(ctx, res, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Users destroyed together are deleted in batches.
+	err := r.userDeleteBatcher.Delete(ctx, data.Id.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			// Log this for debugging purposes, but do not return an error to Terraform.
+			tflog.Warn(ctx, "User already deleted in Google Workspace", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Google User",
+			fmt.Sprintf("Could not delete user ID %s: %v", data.Id.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+}
+
+// ImportState accepts either the numeric user id or the primary email, Read
+// resolves either to the numeric id.
+func (r *UserResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandUser builds the API representation of the managed user attributes,
+// excluding the password and admin status which are handled separately.
+func (r *UserResource) expandUser(ctx context.Context, data *UserResourceModel) (*admin.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	u := &admin.User{
+		PrimaryEmail:               data.PrimaryEmail.ValueString(),
+		OrgUnitPath:                data.OrgUnitPath.ValueString(),
+		Suspended:                  data.Suspended.ValueBool(),
+		ChangePasswordAtNextLogin:  data.ChangePasswordAtNextLogin.ValueBool(),
+		IncludeInGlobalAddressList: data.IncludeInGlobalAddressList.ValueBool(),
+		ForceSendFields:            []string{"Suspended", "ChangePasswordAtNextLogin", "IncludeInGlobalAddressList"},
+	}
+
+	if data.Name != nil {
+		u.Name = &admin.UserName{
+			GivenName:  data.Name.GivenName.ValueString(),
+			FamilyName: data.Name.FamilyName.ValueString(),
+		}
+	}
+
+	if !data.Emails.IsNull() {
+		u.Emails = expandUserEmails(ctx, data.Emails, &diags)
+	}
+	if !data.Phones.IsNull() || !data.WorkPhone.IsNull() {
+		phones := expandUserPhones(ctx, data.Phones, &diags)
+		if !data.WorkPhone.IsNull() {
+			phones = withWorkPhone(phones, data.WorkPhone.ValueString())
+		}
+		u.Phones = phones
+	}
+	if !data.Addresses.IsNull() {
+		u.Addresses = expandUserAddresses(ctx, data.Addresses, &diags)
+	}
+	if !data.Organizations.IsNull() {
+		u.Organizations = expandUserOrganizations(ctx, data.Organizations, &diags)
+	}
+	if !data.Relations.IsNull() {
+		u.Relations = expandUserRelations(ctx, data.Relations, &diags)
+	}
+	if !data.PosixAccounts.IsNull() {
+		u.PosixAccounts = expandUserPosixAccounts(ctx, data.PosixAccounts, &diags)
+	}
+	if !data.SshPublicKeys.IsNull() {
+		u.SshPublicKeys = expandUserSshPublicKeys(ctx, data.SshPublicKeys, &diags)
+	}
+	if !data.Languages.IsNull() {
+		u.Languages = expandUserLanguages(ctx, data.Languages, &diags)
+	}
+	if !data.Locations.IsNull() {
+		u.Locations = expandUserLocations(ctx, data.Locations, &diags)
+	}
+	if !data.ExternalIds.IsNull() {
+		u.ExternalIds = expandUserExternalIds(ctx, data.ExternalIds, &diags)
+	}
+	if !data.Keywords.IsNull() {
+		u.Keywords = expandUserKeywords(ctx, data.Keywords, &diags)
+	}
+
+	return u, diags
+}
+
+// expandUserPatch builds a patch of the managed user attributes that differ
+// between the plan in data and the prior state. Attributes left out of the
+// patch are not touched by Google. Like expandUser it excludes the password
+// and admin status.
+func (r *UserResource) expandUserPatch(ctx context.Context, data, state *UserResourceModel) (*admin.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	u := &admin.User{}
+
+	if !data.PrimaryEmail.Equal(state.PrimaryEmail) {
+		u.PrimaryEmail = data.PrimaryEmail.ValueString()
+	}
+	if !data.OrgUnitPath.Equal(state.OrgUnitPath) {
+		u.OrgUnitPath = data.OrgUnitPath.ValueString()
+	}
+	if !data.Suspended.Equal(state.Suspended) {
+		u.Suspended = data.Suspended.ValueBool()
+		u.ForceSendFields = append(u.ForceSendFields, "Suspended")
+	}
+	if !data.ChangePasswordAtNextLogin.Equal(state.ChangePasswordAtNextLogin) {
+		u.ChangePasswordAtNextLogin = data.ChangePasswordAtNextLogin.ValueBool()
+		u.ForceSendFields = append(u.ForceSendFields, "ChangePasswordAtNextLogin")
+	}
+	if !data.IncludeInGlobalAddressList.Equal(state.IncludeInGlobalAddressList) {
+		u.IncludeInGlobalAddressList = data.IncludeInGlobalAddressList.ValueBool()
+		u.ForceSendFields = append(u.ForceSendFields, "IncludeInGlobalAddressList")
+	}
+
+	if data.Name != nil && (state.Name == nil ||
+		!data.Name.GivenName.Equal(state.Name.GivenName) ||
+		!data.Name.FamilyName.Equal(state.Name.FamilyName)) {
+		u.Name = &admin.UserName{
+			GivenName:  data.Name.GivenName.ValueString(),
+			FamilyName: data.Name.FamilyName.ValueString(),
+		}
+	}
+
+	// Lists are replaced as a whole. Like on Create a list that is not set
+	// is not managed and left as is, an empty set clears it.
+	if !data.Emails.IsNull() && !data.Emails.Equal(state.Emails) {
+		u.Emails = expandUserEmails(ctx, data.Emails, &diags)
+	}
+	// Without phones a changed work_phone is merged into the current phone
+	// numbers by Update.
+	if !data.Phones.IsNull() && (!data.Phones.Equal(state.Phones) || !data.WorkPhone.Equal(state.WorkPhone)) {
+		phones := expandUserPhones(ctx, data.Phones, &diags)
+		if !data.WorkPhone.IsNull() {
+			phones = withWorkPhone(phones, data.WorkPhone.ValueString())
+		}
+		u.Phones = phones
+	}
+	if !data.Addresses.IsNull() && !data.Addresses.Equal(state.Addresses) {
+		u.Addresses = expandUserAddresses(ctx, data.Addresses, &diags)
+	}
+	if !data.Organizations.IsNull() && !data.Organizations.Equal(state.Organizations) {
+		u.Organizations = expandUserOrganizations(ctx, data.Organizations, &diags)
+	}
+	if !data.Relations.IsNull() && !data.Relations.Equal(state.Relations) {
+		u.Relations = expandUserRelations(ctx, data.Relations, &diags)
+	}
+	if !data.PosixAccounts.IsNull() && !data.PosixAccounts.Equal(state.PosixAccounts) {
+		u.PosixAccounts = expandUserPosixAccounts(ctx, data.PosixAccounts, &diags)
+	}
+	if !data.SshPublicKeys.IsNull() && !data.SshPublicKeys.Equal(state.SshPublicKeys) {
+		u.SshPublicKeys = expandUserSshPublicKeys(ctx, data.SshPublicKeys, &diags)
+	}
+	if !data.Languages.IsNull() && !data.Languages.Equal(state.Languages) {
+		u.Languages = expandUserLanguages(ctx, data.Languages, &diags)
+	}
+	if !data.Locations.IsNull() && !data.Locations.Equal(state.Locations) {
+		u.Locations = expandUserLocations(ctx, data.Locations, &diags)
+	}
+	if !data.ExternalIds.IsNull() && !data.ExternalIds.Equal(state.ExternalIds) {
+		u.ExternalIds = expandUserExternalIds(ctx, data.ExternalIds, &diags)
+	}
+	if !data.Keywords.IsNull() && !data.Keywords.Equal(state.Keywords) {
+		u.Keywords = expandUserKeywords(ctx, data.Keywords, &diags)
+	}
+
+	return u, diags
+}
+
+// flattenUser copies the API representation of a user into the model. The
+// password and hash function are never returned by Google and are left as is.
+// Lists that are null in data are not managed and stay null.
+func (r *UserResource) flattenUser(ctx context.Context, u *admin.User, data *UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(u.Id)
+	data.Etag = types.StringValue(u.Etag)
+	data.PrimaryEmail = types.StringValue(u.PrimaryEmail)
+	data.OrgUnitPath = types.StringValue(u.OrgUnitPath)
+	data.Suspended = types.BoolValue(u.Suspended)
+	data.ChangePasswordAtNextLogin = types.BoolValue(u.ChangePasswordAtNextLogin)
+	data.IsAdmin = types.BoolValue(u.IsAdmin)
+	data.IncludeInGlobalAddressList = types.BoolValue(u.IncludeInGlobalAddressList)
+	data.IsMailboxSetup = types.BoolValue(u.IsMailboxSetup)
+	data.CreationTime = types.StringValue(u.CreationTime)
+	data.Password = types.StringNull()
+
+	if u.Name != nil {
+		data.Name = &UserNameModel{
+			GivenName:  types.StringValue(u.Name.GivenName),
+			FamilyName: types.StringValue(u.Name.FamilyName),
+		}
+	}
+
+	if !data.Emails.IsNull() {
+		data.Emails = flattenUserEmails(ctx, u, data.Emails, &diags)
+	}
+	if !data.WorkPhone.IsNull() {
+		data.WorkPhone = flattenUserWorkPhone(u, &diags)
+	}
+	if !data.Phones.IsNull() {
+		data.Phones = flattenUserPhones(ctx, u, !data.WorkPhone.IsNull(), &diags)
+	}
+	if !data.Addresses.IsNull() {
+		data.Addresses = flattenUserAddresses(ctx, u, &diags)
+	}
+	if !data.Organizations.IsNull() {
+		data.Organizations = flattenUserOrganizations(ctx, u, data.Organizations, &diags)
+	}
+	if !data.Relations.IsNull() {
+		data.Relations = flattenUserRelations(ctx, u, data.Relations, &diags)
+	}
+	if !data.PosixAccounts.IsNull() {
+		data.PosixAccounts = flattenUserPosixAccounts(ctx, u, &diags)
+	}
+	if !data.SshPublicKeys.IsNull() {
+		data.SshPublicKeys = flattenUserSshPublicKeys(ctx, u, data.SshPublicKeys, &diags)
+	}
+	if !data.Languages.IsNull() {
+		data.Languages = flattenUserLanguages(ctx, u, &diags)
+	}
+	if !data.Locations.IsNull() {
+		data.Locations = flattenUserLocations(ctx, u, &diags)
+	}
+	if !data.ExternalIds.IsNull() {
+		data.ExternalIds = flattenUserExternalIds(ctx, u, &diags)
+	}
+	if !data.Keywords.IsNull() {
+		data.Keywords = flattenUserKeywords(ctx, u, &diags)
+	}
+
+	return diags
+}
+
+func expandUserEmails(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserEmail {
+	var models []UserEmailModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	emails := make([]admin.UserEmail, 0, len(models))
+	for _, m := range models {
+		emails = append(emails, admin.UserEmail{
+			Address:    m.Address.ValueString(),
+			Type:       m.Type.ValueString(),
+			CustomType: m.CustomType.ValueString(),
+			Primary:    m.Primary.ValueBool(),
+		})
+	}
+
+	return emails
+}
+
+func expandUserPhones(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserPhone {
+	var models []UserPhoneModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	phones := make([]admin.UserPhone, 0, len(models))
+	for _, m := range models {
+		phones = append(phones, admin.UserPhone{
+			Value:      m.Value.ValueString(),
+			Type:       m.Type.ValueString(),
+			CustomType: m.CustomType.ValueString(),
+			Primary:    m.Primary.ValueBool(),
+		})
+	}
+
+	return phones
+}
+
+func expandUserAddresses(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserAddress {
+	var models []UserAddressModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	addresses := make([]admin.UserAddress, 0, len(models))
+	for _, m := range models {
+		addresses = append(addresses, admin.UserAddress{
+			Type:               m.Type.ValueString(),
+			CustomType:         m.CustomType.ValueString(),
+			Primary:            m.Primary.ValueBool(),
+			Formatted:          m.Formatted.ValueString(),
+			SourceIsStructured: m.Formatted.IsNull(),
+			StreetAddress:      m.StreetAddress.ValueString(),
+			ExtendedAddress:    m.ExtendedAddress.ValueString(),
+			PoBox:              m.PoBox.ValueString(),
+			Locality:           m.Locality.ValueString(),
+			Region:             m.Region.ValueString(),
+			PostalCode:         m.PostalCode.ValueString(),
+			Country:            m.Country.ValueString(),
+			CountryCode:        m.CountryCode.ValueString(),
+		})
+	}
+
+	return addresses
+}
+
+func expandUserOrganizations(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserOrganization {
+	var models []UserOrganizationModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	organizations := make([]admin.UserOrganization, 0, len(models))
+	for _, m := range models {
+		var forceSendFields []string
+		if !m.FullTimeEquivalent.IsNull() {
+			// A configured 0 is sent as well, it would be left out otherwise.
+			forceSendFields = append(forceSendFields, "FullTimeEquivalent")
+		}
+		organizations = append(organizations, admin.UserOrganization{
+			Name:               m.Name.ValueString(),
+			Title:              m.Title.ValueString(),
+			Department:         m.Department.ValueString(),
+			CostCenter:         m.CostCenter.ValueString(),
+			Description:        m.Description.ValueString(),
+			Location:           m.Location.ValueString(),
+			Domain:             m.Domain.ValueString(),
+			Symbol:             m.Symbol.ValueString(),
+			FullTimeEquivalent: m.FullTimeEquivalent.ValueInt64(),
+			Type:               m.Type.ValueString(),
+			CustomType:         m.CustomType.ValueString(),
+			Primary:            m.Primary.ValueBool(),
+			ForceSendFields:    forceSendFields,
+		})
+	}
+
+	return organizations
+}
+
+func expandUserRelations(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserRelation {
+	var models []UserRelationModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	relations := make([]admin.UserRelation, 0, len(models))
+	for _, m := range models {
+		relations = append(relations, admin.UserRelation{
+			Value:      m.Value.ValueString(),
+			Type:       m.Type.ValueString(),
+			CustomType: m.CustomType.ValueString(),
+		})
+	}
+
+	return relations
+}
+
+func expandUserPosixAccounts(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserPosixAccount {
+	var models []UserPosixAccountModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	accounts := make([]admin.UserPosixAccount, 0, len(models))
+	for _, m := range models {
+		accounts = append(accounts, admin.UserPosixAccount{
+			Username:      m.Username.ValueString(),
+			Uid:           uint64(m.Uid.ValueInt64()),
+			Gid:           uint64(m.Gid.ValueInt64()),
+			HomeDirectory: m.HomeDirectory.ValueString(),
+			Shell:         m.Shell.ValueString(),
+			SystemId:      m.SystemId.ValueString(),
+		})
+	}
+
+	return accounts
+}
+
+// expandUserSshPublicKeys leaves out keys that already expired, Google would
+// drop them anyway.
+func expandUserSshPublicKeys(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserSshPublicKey {
+	var models []UserSshPublicKeyModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	keys := make([]admin.UserSshPublicKey, 0, len(models))
+	for _, m := range models {
+		if sshPublicKeyExpired(m) {
+			continue
+		}
+		keys = append(keys, admin.UserSshPublicKey{
+			Key:                m.Key.ValueString(),
+			ExpirationTimeUsec: m.ExpirationTimeUsec.ValueInt64(),
+		})
+	}
+
+	return keys
+}
+
+func expandUserLanguages(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserLanguage {
+	var models []UserLanguageModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	languages := make([]admin.UserLanguage, 0, len(models))
+	for _, m := range models {
+		languages = append(languages, admin.UserLanguage{
+			LanguageCode:   m.LanguageCode.ValueString(),
+			CustomLanguage: m.CustomLanguage.ValueString(),
+			Preference:     m.Preference.ValueString(),
+		})
+	}
+
+	return languages
+}
+
+func expandUserLocations(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserLocation {
+	var models []UserLocationModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	locations := make([]admin.UserLocation, 0, len(models))
+	for _, m := range models {
+		locations = append(locations, admin.UserLocation{
+			Type:         m.Type.ValueString(),
+			CustomType:   m.CustomType.ValueString(),
+			Area:         m.Area.ValueString(),
+			BuildingId:   m.BuildingId.ValueString(),
+			FloorName:    m.FloorName.ValueString(),
+			FloorSection: m.FloorSection.ValueString(),
+			DeskCode:     m.DeskCode.ValueString(),
+		})
+	}
+
+	return locations
+}
+
+func expandUserExternalIds(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserExternalId {
+	var models []UserExternalIdModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	externalIds := make([]admin.UserExternalId, 0, len(models))
+	for _, m := range models {
+		externalIds = append(externalIds, admin.UserExternalId{
+			Value:      m.Value.ValueString(),
+			Type:       m.Type.ValueString(),
+			CustomType: m.CustomType.ValueString(),
+		})
+	}
+
+	return externalIds
+}
+
+func expandUserKeywords(ctx context.Context, set types.Set, diags *diag.Diagnostics) []admin.UserKeyword {
+	var models []UserKeywordModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	keywords := make([]admin.UserKeyword, 0, len(models))
+	for _, m := range models {
+		keywords = append(keywords, admin.UserKeyword{
+			Value:      m.Value.ValueString(),
+			Type:       m.Type.ValueString(),
+			CustomType: m.CustomType.ValueString(),
+		})
+	}
+
+	return keywords
+}
+
+// flattenUserEmails returns the email addresses of u. The primary address and
+// aliases that Google adds to the list are left out unless prior has them.
+func flattenUserEmails(ctx context.Context, u *admin.User, prior types.Set, diags *diag.Diagnostics) types.Set {
+	var emails []admin.UserEmail
+	decodeUserList(u.Emails, &emails, "emails", diags)
+
+	configured := map[string]bool{}
+	var priorModels []UserEmailModel
+	diags.Append(prior.ElementsAs(ctx, &priorModels, false)...)
+	for _, m := range priorModels {
+		configured[canonicalKey(m.Address.ValueString())] = true
+	}
+
+	implicit := map[string]bool{canonicalKey(u.PrimaryEmail): true}
+	for _, alias := range u.Aliases {
+		implicit[canonicalKey(alias)] = true
+	}
+	for _, alias := range u.NonEditableAliases {
+		implicit[canonicalKey(alias)] = true
+	}
+
+	values := make([]UserEmailModel, 0, len(emails))
+	for _, e := range emails {
+		key := canonicalKey(e.Address)
+		if implicit[key] && !configured[key] {
+			continue
+		}
+		values = append(values, UserEmailModel{
+			Address:    types.StringValue(e.Address),
+			Type:       types.StringValue(e.Type),
+			CustomType: stringOrNull(e.CustomType),
+			Primary:    types.BoolValue(e.Primary),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userEmailAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+// flattenUserPhones returns the phone numbers of u, without the primary work
+// phone when it is managed by work_phone.
+func flattenUserPhones(ctx context.Context, u *admin.User, workPhone bool, diags *diag.Diagnostics) types.Set {
+	var phones []admin.UserPhone
+	decodeUserList(u.Phones, &phones, "phones", diags)
+
+	values := make([]UserPhoneModel, 0, len(phones))
+	for _, p := range phones {
+		if workPhone && isPrimaryWorkPhone(p) {
+			continue
+		}
+		values = append(values, UserPhoneModel{
+			Value:      types.StringValue(p.Value),
+			Type:       types.StringValue(p.Type),
+			CustomType: stringOrNull(p.CustomType),
+			Primary:    types.BoolValue(p.Primary),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userPhoneAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+// flattenUserWorkPhone returns the primary work phone number of u, null when
+// it has none.
+func flattenUserWorkPhone(u *admin.User, diags *diag.Diagnostics) types.String {
+	var phones []admin.UserPhone
+	decodeUserList(u.Phones, &phones, "phones", diags)
+
+	for _, p := range phones {
+		if isPrimaryWorkPhone(p) {
+			return types.StringValue(p.Value)
+		}
+	}
+
+	return types.StringNull()
+}
+
+// withWorkPhone replaces the primary work phone in phones with value, or
+// removes it when value is empty.
+func withWorkPhone(phones []admin.UserPhone, value string) []admin.UserPhone {
+	result := make([]admin.UserPhone, 0, len(phones)+1)
+	for _, p := range phones {
+		if !isPrimaryWorkPhone(p) {
+			result = append(result, p)
+		}
+	}
+
+	if value != "" {
+		result = append(result, admin.UserPhone{
+			Value:   value,
+			Type:    "work",
+			Primary: true,
+		})
+	}
+
+	return result
+}
+
+// isPrimaryWorkPhone reports whether p is the phone number work_phone manages.
+func isPrimaryWorkPhone(p admin.UserPhone) bool {
+	return p.Type == "work" && p.Primary
+}
+
+// flattenUserAddresses returns the addresses of u. Google derives a formatted
+// address from structured fields, it is only kept for unstructured addresses.
+func flattenUserAddresses(ctx context.Context, u *admin.User, diags *diag.Diagnostics) types.Set {
+	var addresses []admin.UserAddress
+	decodeUserList(u.Addresses, &addresses, "addresses", diags)
+
+	values := make([]UserAddressModel, 0, len(addresses))
+	for _, a := range addresses {
+		formatted := stringOrNull(a.Formatted)
+		if a.SourceIsStructured {
+			formatted = types.StringNull()
+		}
+		values = append(values, UserAddressModel{
+			Type:            types.StringValue(a.Type),
+			CustomType:      stringOrNull(a.CustomType),
+			Primary:         types.BoolValue(a.Primary),
+			Formatted:       formatted,
+			StreetAddress:   stringOrNull(a.StreetAddress),
+			ExtendedAddress: stringOrNull(a.ExtendedAddress),
+			PoBox:           stringOrNull(a.PoBox),
+			Locality:        stringOrNull(a.Locality),
+			Region:          stringOrNull(a.Region),
+			PostalCode:      stringOrNull(a.PostalCode),
+			Country:         stringOrNull(a.Country),
+			CountryCode:     stringOrNull(a.CountryCode),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userAddressAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+// flattenUserOrganizations returns the organizations of u. Google leaves out a
+// full_time_equivalent of 0, it is kept when prior has the organization with
+// a full_time_equivalent of 0.
+func flattenUserOrganizations(ctx context.Context, u *admin.User, prior types.Set, diags *diag.Diagnostics) types.Set {
+	var organizations []admin.UserOrganization
+	decodeUserList(u.Organizations, &organizations, "organizations", diags)
+
+	var priorModels []UserOrganizationModel
+	diags.Append(prior.ElementsAs(ctx, &priorModels, false)...)
+
+	values := make([]UserOrganizationModel, 0, len(organizations))
+	for _, o := range organizations {
+		fte := types.Int64Null()
+		if o.FullTimeEquivalent != 0 {
+			fte = types.Int64Value(o.FullTimeEquivalent)
+		}
+		value := UserOrganizationModel{
+			Name:               stringOrNull(o.Name),
+			Title:              stringOrNull(o.Title),
+			Department:         stringOrNull(o.Department),
+			CostCenter:         stringOrNull(o.CostCenter),
+			Description:        stringOrNull(o.Description),
+			Location:           stringOrNull(o.Location),
+			Domain:             stringOrNull(o.Domain),
+			Symbol:             stringOrNull(o.Symbol),
+			FullTimeEquivalent: fte,
+			Type:               types.StringValue(o.Type),
+			CustomType:         stringOrNull(o.CustomType),
+			Primary:            types.BoolValue(o.Primary),
+		}
+
+		if fte.IsNull() {
+			for _, m := range priorModels {
+				zero := m.FullTimeEquivalent
+				m.FullTimeEquivalent = fte
+				if zero.Equal(types.Int64Value(0)) && m == value {
+					value.FullTimeEquivalent = zero
+					break
+				}
+			}
+		}
+
+		values = append(values, value)
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userOrganizationAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+// flattenUserRelations returns the relations of u. Google may return an email
+// address in a different case than configured, in which case the configured
+// value from prior is kept.
+func flattenUserRelations(ctx context.Context, u *admin.User, prior types.Set, diags *diag.Diagnostics) types.Set {
+	var relations []admin.UserRelation
+	decodeUserList(u.Relations, &relations, "relations", diags)
+
+	var priorModels []UserRelationModel
+	diags.Append(prior.ElementsAs(ctx, &priorModels, false)...)
+
+	values := make([]UserRelationModel, 0, len(relations))
+	for _, r := range relations {
+		value := types.StringValue(r.Value)
+		for _, m := range priorModels {
+			if m.Type.ValueString() == r.Type && canonicalKey(m.Value.ValueString()) == canonicalKey(r.Value) {
+				value = m.Value
+				break
+			}
+		}
+		values = append(values, UserRelationModel{
+			Value:      value,
+			Type:       types.StringValue(r.Type),
+			CustomType: stringOrNull(r.CustomType),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userRelationAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+func flattenUserPosixAccounts(ctx context.Context, u *admin.User, diags *diag.Diagnostics) types.Set {
+	var accounts []admin.UserPosixAccount
+	decodeUserList(u.PosixAccounts, &accounts, "POSIX accounts", diags)
+
+	values := make([]UserPosixAccountModel, 0, len(accounts))
+	for _, a := range accounts {
+		values = append(values, UserPosixAccountModel{
+			Username:      types.StringValue(a.Username),
+			Uid:           types.Int64Value(int64(a.Uid)),
+			Gid:           types.Int64Value(int64(a.Gid)),
+			HomeDirectory: stringOrNull(a.HomeDirectory),
+			Shell:         stringOrNull(a.Shell),
+			SystemId:      stringOrNull(a.SystemId),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userPosixAccountAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+// flattenUserSshPublicKeys returns the SSH keys of u. Keys in prior that
+// expired and were dropped by Google are kept, so they don't show up as a
+// difference, as is the configured form of keys that Google trimmed.
+func flattenUserSshPublicKeys(ctx context.Context, u *admin.User, prior types.Set, diags *diag.Diagnostics) types.Set {
+	var keys []admin.UserSshPublicKey
+	decodeUserList(u.SshPublicKeys, &keys, "SSH public keys", diags)
+
+	var priorModels []UserSshPublicKeyModel
+	diags.Append(prior.ElementsAs(ctx, &priorModels, false)...)
+
+	configured := map[string]types.String{}
+	for _, m := range priorModels {
+		configured[strings.TrimSpace(m.Key.ValueString())] = m.Key
+	}
+
+	returned := map[string]bool{}
+	values := make([]UserSshPublicKeyModel, 0, len(keys))
+	for _, k := range keys {
+		key, ok := configured[strings.TrimSpace(k.Key)]
+		if !ok {
+			key = types.StringValue(k.Key)
+		}
+		returned[strings.TrimSpace(k.Key)] = true
+
+		expiration := types.Int64Null()
+		if k.ExpirationTimeUsec != 0 {
+			expiration = types.Int64Value(k.ExpirationTimeUsec)
+		}
+		values = append(values, UserSshPublicKeyModel{
+			Key:                key,
+			ExpirationTimeUsec: expiration,
+		})
+	}
+
+	for _, m := range priorModels {
+		if sshPublicKeyExpired(m) && !returned[strings.TrimSpace(m.Key.ValueString())] {
+			values = append(values, m)
+		}
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userSshPublicKeyAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+// sshPublicKeyExpired reports whether the expiration time of key has passed.
+func sshPublicKeyExpired(key UserSshPublicKeyModel) bool {
+	expiration := key.ExpirationTimeUsec.ValueInt64()
+	return expiration > 0 && time.UnixMicro(expiration).Before(time.Now())
+}
+
+func flattenUserLanguages(ctx context.Context, u *admin.User, diags *diag.Diagnostics) types.Set {
+	var languages []admin.UserLanguage
+	decodeUserList(u.Languages, &languages, "languages", diags)
+
+	values := make([]UserLanguageModel, 0, len(languages))
+	for _, l := range languages {
+		values = append(values, UserLanguageModel{
+			LanguageCode:   stringOrNull(l.LanguageCode),
+			CustomLanguage: stringOrNull(l.CustomLanguage),
+			Preference:     stringOrNull(l.Preference),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userLanguageAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+func flattenUserLocations(ctx context.Context, u *admin.User, diags *diag.Diagnostics) types.Set {
+	var locations []admin.UserLocation
+	decodeUserList(u.Locations, &locations, "locations", diags)
+
+	values := make([]UserLocationModel, 0, len(locations))
+	for _, l := range locations {
+		values = append(values, UserLocationModel{
+			Type:         types.StringValue(l.Type),
+			CustomType:   stringOrNull(l.CustomType),
+			Area:         stringOrNull(l.Area),
+			BuildingId:   stringOrNull(l.BuildingId),
+			FloorName:    stringOrNull(l.FloorName),
+			FloorSection: stringOrNull(l.FloorSection),
+			DeskCode:     stringOrNull(l.DeskCode),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userLocationAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+func flattenUserExternalIds(ctx context.Context, u *admin.User, diags *diag.Diagnostics) types.Set {
+	var externalIds []admin.UserExternalId
+	decodeUserList(u.ExternalIds, &externalIds, "external IDs", diags)
+
+	values := make([]UserExternalIdModel, 0, len(externalIds))
+	for _, e := range externalIds {
+		values = append(values, UserExternalIdModel{
+			Value:      types.StringValue(e.Value),
+			Type:       types.StringValue(e.Type),
+			CustomType: stringOrNull(e.CustomType),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userExternalIdAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+func flattenUserKeywords(ctx context.Context, u *admin.User, diags *diag.Diagnostics) types.Set {
+	var keywords []admin.UserKeyword
+	decodeUserList(u.Keywords, &keywords, "keywords", diags)
+
+	values := make([]UserKeywordModel, 0, len(keywords))
+	for _, k := range keywords {
+		values = append(values, UserKeywordModel{
+			Value:      types.StringValue(k.Value),
+			Type:       types.StringValue(k.Type),
+			CustomType: stringOrNull(k.CustomType),
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userKeywordAttrTypes}, values)
+	diags.Append(d...)
+	return set
+}
+
+// decodeUserList decodes one of the list fields of admin.User, which the
+// generated client leaves undecoded, into out.
+func decodeUserList(v interface{}, out interface{}, field string, diags *diag.Diagnostics) {
+	if v == nil {
+		return
+	}
+
+	b, err := json.Marshal(v)
+	if err == nil {
+		err = json.Unmarshal(b, out)
+	}
+	if err != nil {
+		diags.AddError(
+			"Unexpected User Field",
+			fmt.Sprintf("Unable to decode the %s of the user: %s", field, err),
+		)
+	}
+}
+
+// etagUnknownOnChange marks the planned etag of an updated resource unknown,
+// as Google gives the resource a new etag on every change. The etag keeps its
+// value from state when nothing changes, through UseStateForUnknown.
+func etagUnknownOnChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return nil
+	}
+
+	return resp.Plan.SetAttribute(ctx, path.Root("etag"), types.StringUnknown())
+}
+
+// stringOrNull keeps optional attributes that Google leaves empty null.
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
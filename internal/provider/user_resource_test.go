@@ -0,0 +1,787 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// testUserModel returns the model of a user as stored in state, with none of
+// the lists managed.
+func testUserModel() UserResourceModel {
+	return UserResourceModel{
+		PrimaryEmail:               types.StringValue("jdoe@example.com"),
+		Name:                       &UserNameModel{GivenName: types.StringValue("John"), FamilyName: types.StringValue("Doe")},
+		OrgUnitPath:                types.StringValue("/"),
+		Suspended:                  types.BoolValue(false),
+		ChangePasswordAtNextLogin:  types.BoolValue(false),
+		IsAdmin:                    types.BoolValue(false),
+		IncludeInGlobalAddressList: types.BoolValue(true),
+		Emails:                     types.SetNull(types.ObjectType{AttrTypes: userEmailAttrTypes}),
+		Phones:                     types.SetNull(types.ObjectType{AttrTypes: userPhoneAttrTypes}),
+		WorkPhone:                  types.StringNull(),
+		Addresses:                  types.SetNull(types.ObjectType{AttrTypes: userAddressAttrTypes}),
+		Organizations:              types.SetNull(types.ObjectType{AttrTypes: userOrganizationAttrTypes}),
+		Relations:                  types.SetNull(types.ObjectType{AttrTypes: userRelationAttrTypes}),
+		PosixAccounts:              types.SetNull(types.ObjectType{AttrTypes: userPosixAccountAttrTypes}),
+		SshPublicKeys:              types.SetNull(types.ObjectType{AttrTypes: userSshPublicKeyAttrTypes}),
+		Languages:                  types.SetNull(types.ObjectType{AttrTypes: userLanguageAttrTypes}),
+		Locations:                  types.SetNull(types.ObjectType{AttrTypes: userLocationAttrTypes}),
+		ExternalIds:                types.SetNull(types.ObjectType{AttrTypes: userExternalIdAttrTypes}),
+		Keywords:                   types.SetNull(types.ObjectType{AttrTypes: userKeywordAttrTypes}),
+		Etag:                       types.StringValue("e1"),
+		Id:                         types.StringValue("123"),
+	}
+}
+
+// testUserSet returns a set of the nested objects in models.
+func testUserSet(t *testing.T, attrTypes map[string]attr.Type, models any) types.Set {
+	t.Helper()
+
+	set, diags := types.SetValueFrom(context.Background(), types.ObjectType{AttrTypes: attrTypes}, models)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	return set
+}
+
+// testUserOrganization returns an organization with the full time equivalent.
+func testUserOrganization(fte types.Int64) UserOrganizationModel {
+	return UserOrganizationModel{
+		Name:               types.StringNull(),
+		Title:              types.StringNull(),
+		Department:         types.StringValue("Engineering"),
+		CostCenter:         types.StringNull(),
+		Description:        types.StringNull(),
+		Location:           types.StringNull(),
+		Domain:             types.StringNull(),
+		Symbol:             types.StringNull(),
+		FullTimeEquivalent: fte,
+		Type:               types.StringValue("work"),
+		CustomType:         types.StringNull(),
+		Primary:            types.BoolValue(false),
+	}
+}
+
+func TestUserResourceExpandUserPatch(t *testing.T) {
+	email := UserEmailModel{
+		Address:    types.StringValue("john.doe@example.com"),
+		Type:       types.StringValue("work"),
+		CustomType: types.StringNull(),
+		Primary:    types.BoolValue(false),
+	}
+	phone := UserPhoneModel{
+		Value:      types.StringValue("+31 6 1234 5678"),
+		Type:       types.StringValue("mobile"),
+		CustomType: types.StringNull(),
+		Primary:    types.BoolValue(false),
+	}
+
+	tests := map[string]struct {
+		state  func(state *UserResourceModel)
+		update func(data *UserResourceModel)
+		want   string
+	}{
+		"unchanged": {
+			update: func(data *UserResourceModel) {},
+			want:   `{}`,
+		},
+		"hide from the global address list": {
+			update: func(data *UserResourceModel) {
+				data.IncludeInGlobalAddressList = types.BoolValue(false)
+			},
+			want: `{"includeInGlobalAddressList":false}`,
+		},
+		"suspend": {
+			update: func(data *UserResourceModel) {
+				data.Suspended = types.BoolValue(true)
+			},
+			want: `{"suspended":true}`,
+		},
+		"unsuspend": {
+			state: func(state *UserResourceModel) {
+				state.Suspended = types.BoolValue(true)
+			},
+			update: func(data *UserResourceModel) {
+				data.Suspended = types.BoolValue(false)
+			},
+			want: `{"suspended":false}`,
+		},
+		"rename": {
+			update: func(data *UserResourceModel) {
+				data.PrimaryEmail = types.StringValue("john.doe@example.com")
+			},
+			want: `{"primaryEmail":"john.doe@example.com"}`,
+		},
+		"move to another org unit": {
+			update: func(data *UserResourceModel) {
+				data.OrgUnitPath = types.StringValue("/Engineering")
+			},
+			want: `{"orgUnitPath":"/Engineering"}`,
+		},
+		"require a password change": {
+			update: func(data *UserResourceModel) {
+				data.ChangePasswordAtNextLogin = types.BoolValue(true)
+			},
+			want: `{"changePasswordAtNextLogin":true}`,
+		},
+		"family name changed": {
+			update: func(data *UserResourceModel) {
+				data.Name = &UserNameModel{GivenName: types.StringValue("John"), FamilyName: types.StringValue("Smith")}
+			},
+			want: `{"name":{"familyName":"Smith","givenName":"John"}}`,
+		},
+		"emails no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.Emails = testUserSet(t, userEmailAttrTypes, []UserEmailModel{email})
+			},
+			update: func(data *UserResourceModel) {
+				data.Emails = types.SetNull(types.ObjectType{AttrTypes: userEmailAttrTypes})
+			},
+			want: `{}`,
+		},
+		"emails cleared": {
+			state: func(state *UserResourceModel) {
+				state.Emails = testUserSet(t, userEmailAttrTypes, []UserEmailModel{email})
+			},
+			update: func(data *UserResourceModel) {
+				data.Emails = testUserSet(t, userEmailAttrTypes, []UserEmailModel{})
+			},
+			want: `{"emails":[]}`,
+		},
+		"emails managed": {
+			update: func(data *UserResourceModel) {
+				data.Emails = testUserSet(t, userEmailAttrTypes, []UserEmailModel{email})
+			},
+			want: `{"emails":[{"address":"john.doe@example.com","type":"work"}]}`,
+		},
+		"phones no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.Phones = testUserSet(t, userPhoneAttrTypes, []UserPhoneModel{phone})
+			},
+			update: func(data *UserResourceModel) {
+				data.Phones = types.SetNull(types.ObjectType{AttrTypes: userPhoneAttrTypes})
+			},
+			want: `{}`,
+		},
+		"work_phone changed with phones": {
+			state: func(state *UserResourceModel) {
+				state.Phones = testUserSet(t, userPhoneAttrTypes, []UserPhoneModel{phone})
+			},
+			update: func(data *UserResourceModel) {
+				data.WorkPhone = types.StringValue("+31 10 123 4567")
+			},
+			want: `{"phones":[{"type":"mobile","value":"+31 6 1234 5678"},{"primary":true,"type":"work","value":"+31 10 123 4567"}]}`,
+		},
+		"organizations no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.Organizations = testUserSet(t, userOrganizationAttrTypes, []UserOrganizationModel{testUserOrganization(types.Int64Null())})
+			},
+			update: func(data *UserResourceModel) {
+				data.Organizations = types.SetNull(types.ObjectType{AttrTypes: userOrganizationAttrTypes})
+			},
+			want: `{}`,
+		},
+		"organization with a full_time_equivalent of 0": {
+			update: func(data *UserResourceModel) {
+				data.Organizations = testUserSet(t, userOrganizationAttrTypes, []UserOrganizationModel{testUserOrganization(types.Int64Value(0))})
+			},
+			want: `{"organizations":[{"department":"Engineering","fullTimeEquivalent":0,"type":"work"}]}`,
+		},
+		"relations no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.Relations = testUserSet(t, userRelationAttrTypes, []UserRelationModel{{
+					Value:      types.StringValue("manager@example.com"),
+					Type:       types.StringValue("manager"),
+					CustomType: types.StringNull(),
+				}})
+			},
+			update: func(data *UserResourceModel) {
+				data.Relations = types.SetNull(types.ObjectType{AttrTypes: userRelationAttrTypes})
+			},
+			want: `{}`,
+		},
+		"posix accounts no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.PosixAccounts = testUserSet(t, userPosixAccountAttrTypes, []UserPosixAccountModel{{
+					Username:      types.StringValue("jdoe"),
+					Uid:           types.Int64Value(1001),
+					Gid:           types.Int64Value(1001),
+					HomeDirectory: types.StringValue("/home/jdoe"),
+					Shell:         types.StringNull(),
+					SystemId:      types.StringNull(),
+				}})
+			},
+			update: func(data *UserResourceModel) {
+				data.PosixAccounts = types.SetNull(types.ObjectType{AttrTypes: userPosixAccountAttrTypes})
+			},
+			want: `{}`,
+		},
+		"ssh public keys no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.SshPublicKeys = testUserSet(t, userSshPublicKeyAttrTypes, []UserSshPublicKeyModel{{
+					Key:                types.StringValue("ssh-ed25519 AAAA"),
+					ExpirationTimeUsec: types.Int64Null(),
+				}})
+			},
+			update: func(data *UserResourceModel) {
+				data.SshPublicKeys = types.SetNull(types.ObjectType{AttrTypes: userSshPublicKeyAttrTypes})
+			},
+			want: `{}`,
+		},
+		"languages no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.Languages = testUserSet(t, userLanguageAttrTypes, []UserLanguageModel{{
+					LanguageCode:   types.StringValue("nl"),
+					CustomLanguage: types.StringNull(),
+					Preference:     types.StringValue("preferred"),
+				}})
+			},
+			update: func(data *UserResourceModel) {
+				data.Languages = types.SetNull(types.ObjectType{AttrTypes: userLanguageAttrTypes})
+			},
+			want: `{}`,
+		},
+		"locations no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.Locations = testUserSet(t, userLocationAttrTypes, []UserLocationModel{{
+					Type:         types.StringValue("desk"),
+					CustomType:   types.StringNull(),
+					Area:         types.StringValue("desk"),
+					BuildingId:   types.StringValue("AMS"),
+					FloorName:    types.StringNull(),
+					FloorSection: types.StringNull(),
+					DeskCode:     types.StringNull(),
+				}})
+			},
+			update: func(data *UserResourceModel) {
+				data.Locations = types.SetNull(types.ObjectType{AttrTypes: userLocationAttrTypes})
+			},
+			want: `{}`,
+		},
+		"external ids no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.ExternalIds = testUserSet(t, userExternalIdAttrTypes, []UserExternalIdModel{{
+					Value:      types.StringValue("E123"),
+					Type:       types.StringValue("organization"),
+					CustomType: types.StringNull(),
+				}})
+			},
+			update: func(data *UserResourceModel) {
+				data.ExternalIds = types.SetNull(types.ObjectType{AttrTypes: userExternalIdAttrTypes})
+			},
+			want: `{}`,
+		},
+		"keywords no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.Keywords = testUserSet(t, userKeywordAttrTypes, []UserKeywordModel{{
+					Value:      types.StringValue("terraform"),
+					Type:       types.StringValue("occupation"),
+					CustomType: types.StringNull(),
+				}})
+			},
+			update: func(data *UserResourceModel) {
+				data.Keywords = types.SetNull(types.ObjectType{AttrTypes: userKeywordAttrTypes})
+			},
+			want: `{}`,
+		},
+		"addresses no longer managed": {
+			state: func(state *UserResourceModel) {
+				state.Addresses = testUserSet(t, userAddressAttrTypes, []UserAddressModel{{
+					Type:            types.StringValue("work"),
+					CustomType:      types.StringNull(),
+					Primary:         types.BoolValue(false),
+					Formatted:       types.StringValue("Amsterdam"),
+					StreetAddress:   types.StringNull(),
+					ExtendedAddress: types.StringNull(),
+					PoBox:           types.StringNull(),
+					Locality:        types.StringNull(),
+					Region:          types.StringNull(),
+					PostalCode:      types.StringNull(),
+					Country:         types.StringNull(),
+					CountryCode:     types.StringNull(),
+				}})
+			},
+			update: func(data *UserResourceModel) {
+				data.Addresses = types.SetNull(types.ObjectType{AttrTypes: userAddressAttrTypes})
+			},
+			want: `{}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &UserResource{}
+			state := testUserModel()
+			if test.state != nil {
+				test.state(&state)
+			}
+			data := state
+			test.update(&data)
+
+			u, diags := r.expandUserPatch(context.Background(), &data, &state)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			got, err := json.Marshal(u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != test.want {
+				t.Errorf("got patch %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUserResourceIncludeInGlobalAddressListUpdatesInPlace(t *testing.T) {
+	r := &UserResource{}
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attr, ok := resp.Schema.Attributes["include_in_global_address_list"].(schema.BoolAttribute)
+	if !ok {
+		t.Fatalf("got attribute of type %T, want a schema.BoolAttribute", resp.Schema.Attributes["include_in_global_address_list"])
+	}
+	if len(attr.PlanModifiers) != 0 {
+		t.Errorf("got %d plan modifiers, want none so that toggling doesn't replace the user", len(attr.PlanModifiers))
+	}
+}
+
+func TestWithWorkPhone(t *testing.T) {
+	mobile := admin.UserPhone{Value: "+31 6 1234 5678", Type: "mobile"}
+	work := admin.UserPhone{Value: "+31 10 123 4567", Type: "work"}
+	primaryWork := admin.UserPhone{Value: "+31 10 765 4321", Type: "work", Primary: true}
+
+	tests := map[string]struct {
+		phones []admin.UserPhone
+		value  string
+		want   []admin.UserPhone
+	}{
+		"added": {
+			phones: []admin.UserPhone{mobile, work},
+			value:  "+31 10 000 0000",
+			want:   []admin.UserPhone{mobile, work, {Value: "+31 10 000 0000", Type: "work", Primary: true}},
+		},
+		"replaced": {
+			phones: []admin.UserPhone{primaryWork, mobile},
+			value:  "+31 10 000 0000",
+			want:   []admin.UserPhone{mobile, {Value: "+31 10 000 0000", Type: "work", Primary: true}},
+		},
+		"removed": {
+			phones: []admin.UserPhone{primaryWork, mobile},
+			value:  "",
+			want:   []admin.UserPhone{mobile},
+		},
+		"no phones": {
+			value: "+31 10 000 0000",
+			want:  []admin.UserPhone{{Value: "+31 10 000 0000", Type: "work", Primary: true}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := withWorkPhone(test.phones, test.value)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUserResourceWorkPhone(t *testing.T) {
+	ctx := context.Background()
+	r := &UserResource{}
+
+	mobile := UserPhoneModel{
+		Value:      types.StringValue("+31 6 1234 5678"),
+		Type:       types.StringValue("mobile"),
+		CustomType: types.StringNull(),
+		Primary:    types.BoolValue(false),
+	}
+	phones, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userPhoneAttrTypes}, []UserPhoneModel{mobile})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	data := testUserModel()
+	data.Phones = phones
+	data.WorkPhone = types.StringValue("+31 10 123 4567")
+
+	u, diags := r.expandUser(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := []admin.UserPhone{
+		{Value: "+31 6 1234 5678", Type: "mobile"},
+		{Value: "+31 10 123 4567", Type: "work", Primary: true},
+	}
+	if !reflect.DeepEqual(u.Phones, want) {
+		t.Fatalf("got phones %+v, want %+v", u.Phones, want)
+	}
+
+	// Google returns the phone numbers as undecoded JSON.
+	u.Id = "123"
+	u.Phones = []interface{}{
+		map[string]interface{}{"value": "+31 10 123 4567", "type": "work", "primary": true},
+		map[string]interface{}{"value": "+31 6 1234 5678", "type": "mobile"},
+	}
+
+	refreshed := data
+	diags = r.flattenUser(ctx, u, &refreshed)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !refreshed.WorkPhone.Equal(data.WorkPhone) {
+		t.Errorf("got work_phone %s, want %s", refreshed.WorkPhone, data.WorkPhone)
+	}
+	if !refreshed.Phones.Equal(data.Phones) {
+		t.Errorf("got phones %s, want %s without the work phone", refreshed.Phones, data.Phones)
+	}
+}
+
+func TestUserResourceValidateConfigWorkPhone(t *testing.T) {
+	ctx := context.Background()
+	r := &UserResource{}
+
+	phone := func(phoneType types.String, primary bool) UserPhoneModel {
+		return UserPhoneModel{
+			Value:      types.StringValue("+31 10 123 4567"),
+			Type:       phoneType,
+			CustomType: types.StringNull(),
+			Primary:    types.BoolValue(primary),
+		}
+	}
+
+	tests := map[string]struct {
+		workPhone types.String
+		phones    []UserPhoneModel
+		wantErr   bool
+	}{
+		"work_phone only": {
+			workPhone: types.StringValue("+31 10 123 4567"),
+		},
+		"phones only": {
+			workPhone: types.StringNull(),
+			phones:    []UserPhoneModel{phone(types.StringValue("work"), true)},
+		},
+		"work_phone and other phones": {
+			workPhone: types.StringValue("+31 10 123 4567"),
+			phones:    []UserPhoneModel{phone(types.StringValue("mobile"), true), phone(types.StringValue("work"), false)},
+		},
+		"work_phone and primary work phone": {
+			workPhone: types.StringValue("+31 10 123 4567"),
+			phones:    []UserPhoneModel{phone(types.StringValue("work"), true)},
+			wantErr:   true,
+		},
+		"work_phone and primary phone of the default type": {
+			workPhone: types.StringValue("+31 10 123 4567"),
+			phones:    []UserPhoneModel{phone(types.StringNull(), true)},
+			wantErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := testUserModel()
+			data.WorkPhone = test.workPhone
+			if test.phones != nil {
+				phones, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: userPhoneAttrTypes}, test.phones)
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				data.Phones = phones
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: newTestResourceConfig(t, r, &data)}, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Errorf("got diagnostics %v, want an error: %t", resp.Diagnostics, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserResourceModifyPlanPasswordVersion(t *testing.T) {
+	tests := map[string]struct {
+		stateVersion types.Int64
+		version      types.Int64
+		password     types.String
+		wantErr      bool
+	}{
+		"unchanged without password": {
+			stateVersion: types.Int64Value(1),
+			version:      types.Int64Value(1),
+			password:     types.StringNull(),
+		},
+		"bumped with password": {
+			stateVersion: types.Int64Value(1),
+			version:      types.Int64Value(2),
+			password:     types.StringValue("correct horse battery staple"),
+		},
+		"first version with password": {
+			stateVersion: types.Int64Null(),
+			version:      types.Int64Value(1),
+			password:     types.StringValue("correct horse battery staple"),
+		},
+		"removed without password": {
+			stateVersion: types.Int64Value(1),
+			version:      types.Int64Null(),
+			password:     types.StringNull(),
+		},
+		"bumped without password": {
+			stateVersion: types.Int64Value(1),
+			version:      types.Int64Value(2),
+			password:     types.StringNull(),
+			wantErr:      true,
+		},
+		"first version without password": {
+			stateVersion: types.Int64Null(),
+			version:      types.Int64Value(1),
+			password:     types.StringNull(),
+			wantErr:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &UserResource{}
+
+			state := testUserModel()
+			state.PasswordVersion = test.stateVersion
+
+			data := testUserModel()
+			data.PasswordVersion = test.version
+			data.Password = test.password
+
+			plan := newTestResourceState(t, r, &data)
+			req := resource.ModifyPlanRequest{
+				Config: newTestResourceConfig(t, r, &data),
+				Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State:  newTestResourceState(t, r, &state),
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Errorf("got diagnostics %v, want an error: %t", resp.Diagnostics, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlattenUserOrganizationsFullTimeEquivalent(t *testing.T) {
+	tests := map[string]struct {
+		prior      []UserOrganizationModel
+		department string
+		returned   int64
+		want       types.Int64
+	}{
+		"set": {
+			prior:      []UserOrganizationModel{testUserOrganization(types.Int64Value(50000))},
+			department: "Engineering",
+			returned:   50000,
+			want:       types.Int64Value(50000),
+		},
+		"not set": {
+			prior:      []UserOrganizationModel{testUserOrganization(types.Int64Null())},
+			department: "Engineering",
+			want:       types.Int64Null(),
+		},
+		"configured 0 is kept": {
+			prior:      []UserOrganizationModel{testUserOrganization(types.Int64Value(0))},
+			department: "Engineering",
+			want:       types.Int64Value(0),
+		},
+		"configured 0 of another organization": {
+			prior:      []UserOrganizationModel{testUserOrganization(types.Int64Value(0))},
+			department: "Sales",
+			want:       types.Int64Null(),
+		},
+		"changed to 0 outside of Terraform": {
+			prior:      []UserOrganizationModel{testUserOrganization(types.Int64Value(50000))},
+			department: "Engineering",
+			want:       types.Int64Null(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			u := &admin.User{Organizations: []admin.UserOrganization{{
+				Department:         test.department,
+				FullTimeEquivalent: test.returned,
+				Type:               "work",
+			}}}
+
+			var diags diag.Diagnostics
+			set := flattenUserOrganizations(ctx, u, testUserSet(t, userOrganizationAttrTypes, test.prior), &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			var got []UserOrganizationModel
+			diags.Append(set.ElementsAs(ctx, &got, false)...)
+			if diags.HasError() || len(got) != 1 {
+				t.Fatalf("got organizations %s, want one: %v", set, diags)
+			}
+			if !got[0].FullTimeEquivalent.Equal(test.want) {
+				t.Errorf("got full_time_equivalent %s, want %s", got[0].FullTimeEquivalent, test.want)
+			}
+		})
+	}
+}
+
+func TestUserResourceCreateSavesCreatedUser(t *testing.T) {
+	user := testAPIResponse{http.StatusOK, `{"id":"123","primaryEmail":"jdoe@example.com","name":{"givenName":"John","familyName":"Doe"},"orgUnitPath":"/","includeInGlobalAddressList":true}`}
+	failed := testAPIResponse{http.StatusInternalServerError, `{"error":{"code":500,"message":"Backend Error"}}`}
+
+	tests := map[string]struct {
+		isAdmin   bool
+		responses map[string]testAPIResponse
+		wantErr   bool
+	}{
+		"created": {
+			responses: map[string]testAPIResponse{
+				"GET /admin/directory/v1/users/123": user,
+			},
+		},
+		"read back fails": {
+			responses: map[string]testAPIResponse{
+				"GET /admin/directory/v1/users/123": failed,
+			},
+			wantErr: true,
+		},
+		"making the user an admin fails": {
+			isAdmin: true,
+			responses: map[string]testAPIResponse{
+				"GET /admin/directory/v1/users/123":            user,
+				"POST /admin/directory/v1/users/123/makeAdmin": failed,
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			test.responses["POST /admin/directory/v1/users"] = testAPIResponse{http.StatusOK, `{"id":"123","primaryEmail":"jdoe@example.com"}`}
+
+			var requests []string
+			r := &UserResource{adminService: newTestAdminService(t, testAPIHandler(t, test.responses, &requests))}
+
+			data := testUserModel()
+			data.IsAdmin = types.BoolValue(test.isAdmin)
+			data.IsMailboxSetup = types.BoolUnknown()
+			data.CreationTime = types.StringUnknown()
+			data.Id = types.StringUnknown()
+			config := data
+			config.Password = types.StringValue("Secret-1")
+
+			plan := newTestResourcePlan(t, r, data)
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+			}
+			r.Create(ctx, resource.CreateRequest{Plan: plan, Config: newTestResourceConfig(t, r, config)}, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", resp.Diagnostics, test.wantErr)
+			}
+
+			var id, email types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("primary_email"), &email)...)
+			if id.ValueString() != "123" || email.ValueString() != "jdoe@example.com" {
+				t.Errorf("got id %s and primary_email %s in state, want the created user to be saved", id, email)
+			}
+		})
+	}
+}
+
+func TestUserResourceEtag(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	r := &UserResource{adminService: newTestAdminService(t, testAPIHandler(t, map[string]testAPIResponse{
+		"GET /admin/directory/v1/users/123": {http.StatusOK, `{"id":"123","primaryEmail":"jdoe@example.com","name":{"givenName":"John","familyName":"Doe"},"orgUnitPath":"/","includeInGlobalAddressList":true,"etag":"e2"}`},
+	}, &requests))}
+
+	// The etag is read into state.
+	state := newTestResourceState(t, r, testUserModel())
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+
+	var etag types.String
+	readResp.Diagnostics.Append(readResp.State.GetAttribute(ctx, path.Root("etag"), &etag)...)
+	if etag.ValueString() != "e2" {
+		t.Errorf("got etag %s after read, want e2", etag)
+	}
+
+	// The etag is kept in the plan unless the user changes.
+	tests := map[string]struct {
+		update   func(data *UserResourceModel)
+		wantEtag types.String
+	}{
+		"unchanged": {
+			wantEtag: types.StringValue("e1"),
+		},
+		"changed": {
+			update: func(data *UserResourceModel) {
+				data.Suspended = types.BoolValue(true)
+			},
+			wantEtag: types.StringUnknown(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := testUserModel()
+			if test.update != nil {
+				test.update(&data)
+			}
+
+			plan := newTestResourcePlan(t, r, data)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:   plan,
+				State:  newTestResourceState(t, r, testUserModel()),
+				Config: newTestResourceConfig(t, r, data),
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("etag"), &got)...)
+			if !got.Equal(test.wantEtag) {
+				t.Errorf("got planned etag %s, want %s", got, test.wantEtag)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserSecuritySettingsDataSource{}
+
+func NewUserSecuritySettingsDataSource() datasource.DataSource {
+	return &UserSecuritySettingsDataSource{}
+}
+
+// UserSecuritySettingsDataSource defines the data source implementation.
+type UserSecuritySettingsDataSource struct {
+	client *http.Client
+
+	adminService *admin.Service
+}
+
+// UserSecuritySettingsDataSourceModel describes the data source data model.
+type UserSecuritySettingsDataSourceModel struct {
+	UserKey         types.String `tfsdk:"user_key"`
+	PrimaryEmail    types.String `tfsdk:"primary_email"`
+	IsEnrolledIn2Sv types.Bool   `tfsdk:"is_enrolled_in_2sv"`
+	IsEnforcedIn2Sv types.Bool   `tfsdk:"is_enforced_in_2sv"`
+	Suspended       types.Bool   `tfsdk:"suspended"`
+	Archived        types.Bool   `tfsdk:"archived"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (d *UserSecuritySettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_security_settings"
+}
+
+func (d *UserSecuritySettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Security posture of a user, such as whether they use 2-Step Verification.
+		Combine it with googleworkspace_users to report on all users.`,
+
+		Attributes: map[string]schema.Attribute{
+			"user_key": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address, alias email address, or unique user ID",
+				Required:            true,
+			},
+			"primary_email": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address",
+				Computed:            true,
+			},
+			"is_enrolled_in_2sv": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has set up 2-Step Verification",
+				Computed:            true,
+			},
+			"is_enforced_in_2sv": schema.BoolAttribute{
+				MarkdownDescription: "Whether 2-Step Verification is enforced for the user",
+				Computed:            true,
+			},
+			"suspended": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is suspended",
+				Computed:            true,
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is archived",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the user",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UserSecuritySettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.adminService = pd.adminService
+}
+
+func (d *UserSecuritySettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserSecuritySettingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userKey := data.UserKey.ValueString()
+
+	u, err := d.adminService.Users.Get(userKey).Fields("id", "primaryEmail", "isEnrolledIn2Sv", "isEnforcedIn2Sv", "suspended", "archived").Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read user '%s', got error: %s", userKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(u.Id)
+	data.PrimaryEmail = types.StringValue(u.PrimaryEmail)
+	data.IsEnrolledIn2Sv = types.BoolValue(u.IsEnrolledIn2Sv)
+	data.IsEnforcedIn2Sv = types.BoolValue(u.IsEnforcedIn2Sv)
+	data.Suspended = types.BoolValue(u.Suspended)
+	data.Archived = types.BoolValue(u.Archived)
+
+	tflog.Trace(ctx, "read user security settings", map[string]interface{}{
+		"user_key": userKey,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
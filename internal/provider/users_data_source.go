@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	Customer    types.String          `tfsdk:"customer"`
+	Query       types.String          `tfsdk:"query"`
+	OrgUnitPath types.String          `tfsdk:"org_unit_path"`
+	ShowDeleted types.Bool            `tfsdk:"show_deleted"`
+	MaxResults  types.Int64           `tfsdk:"max_results"`
+	Users       []UsersDataSourceUser `tfsdk:"users"`
+	TotalCount  types.Int64           `tfsdk:"total_count"`
+	Id          types.String          `tfsdk:"id"`
+}
+
+// Nested Model for "users".
+type UsersDataSourceUser struct {
+	Id           types.String `tfsdk:"id"`
+	PrimaryEmail types.String `tfsdk:"primary_email"`
+	GivenName    types.String `tfsdk:"given_name"`
+	FamilyName   types.String `tfsdk:"family_name"`
+	OrgUnitPath  types.String `tfsdk:"org_unit_path"`
+	Suspended    types.Bool   `tfsdk:"suspended"`
+	IsAdmin      types.Bool   `tfsdk:"is_admin"`
+	CreationTime types.String `tfsdk:"creation_time"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the users of a customer, following every page of results",
+
+		Attributes: map[string]schema.Attribute{
+			"customer": schema.StringAttribute{
+				MarkdownDescription: "The unique ID for the customer's Google Workspace account. Defaults to the provider `customer_id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: `Directory search query, see
+				https://developers.google.com/admin-sdk/directory/v1/guides/search-users`,
+				Optional: true,
+			},
+			"org_unit_path": schema.StringAttribute{
+				MarkdownDescription: "Only list users in this org unit and its children",
+				Optional:            true,
+			},
+			"show_deleted": schema.BoolAttribute{
+				MarkdownDescription: "List deleted users instead of active ones",
+				Optional:            true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "Stop listing once this many users have been returned",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The users matching the filters, sorted by primary_email",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "User identifier",
+							Computed:            true,
+						},
+						"primary_email": schema.StringAttribute{
+							MarkdownDescription: "The user's primary email address",
+							Computed:            true,
+						},
+						"given_name": schema.StringAttribute{
+							MarkdownDescription: "The user's first name",
+							Computed:            true,
+						},
+						"family_name": schema.StringAttribute{
+							MarkdownDescription: "The user's last name",
+							Computed:            true,
+						},
+						"org_unit_path": schema.StringAttribute{
+							MarkdownDescription: "The full path of the parent organization associated with the user",
+							Computed:            true,
+						},
+						"suspended": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is suspended",
+							Computed:            true,
+						},
+						"is_admin": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user has super admin privileges",
+							Computed:            true,
+						},
+						"creation_time": schema.StringAttribute{
+							MarkdownDescription: "The time the user's account was created",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of users returned",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pd.client
+	d.customerId = pd.customerId
+	d.adminService = pd.adminService
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer := d.customerId
+	if !data.Customer.IsNull() && !data.Customer.IsUnknown() {
+		customer = data.Customer.ValueString()
+	}
+
+	var clauses []string
+	if !data.Query.IsNull() && data.Query.ValueString() != "" {
+		clauses = append(clauses, data.Query.ValueString())
+	}
+	if !data.OrgUnitPath.IsNull() {
+		clause, err := directoryQuery("orgUnitPath", "=", data.OrgUnitPath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Org Unit Path", err.Error())
+			return
+		}
+		clauses = append(clauses, clause)
+	}
+
+	call := d.adminService.Users.List().Customer(customer).MaxResults(500)
+	if len(clauses) > 0 {
+		call = call.Query(strings.Join(clauses, " "))
+	}
+	if data.ShowDeleted.ValueBool() {
+		call = call.ShowDeleted("true")
+	}
+
+	maxResults := int(data.MaxResults.ValueInt64())
+
+	data.Users = []UsersDataSourceUser{}
+
+	pageToken := ""
+	for {
+		page, err := call.PageToken(pageToken).Context(ctx).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list users for customer '%s', got error: %s", customer, formatAPIError(err)),
+			)
+			return
+		}
+
+		for _, u := range page.Users {
+			if maxResults > 0 && len(data.Users) >= maxResults {
+				break
+			}
+			data.Users = append(data.Users, flattenUsersDataSourceUser(u))
+		}
+
+		pageToken = page.NextPageToken
+		if pageToken == "" || (maxResults > 0 && len(data.Users) >= maxResults) {
+			break
+		}
+	}
+
+	data.Customer = types.StringValue(customer)
+	data.TotalCount = types.Int64Value(int64(len(data.Users)))
+	sortByKey(data.Users, func(u UsersDataSourceUser) string { return u.PrimaryEmail.ValueString() })
+
+	data.Id = types.StringValue(customer)
+
+	tflog.Trace(ctx, "read users", map[string]interface{}{
+		"customer": customer,
+		"count":    len(data.Users),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenUsersDataSourceUser(u *admin.User) UsersDataSourceUser {
+	user := UsersDataSourceUser{
+		Id:           types.StringValue(u.Id),
+		PrimaryEmail: types.StringValue(u.PrimaryEmail),
+		GivenName:    types.StringValue(""),
+		FamilyName:   types.StringValue(""),
+		OrgUnitPath:  types.StringValue(u.OrgUnitPath),
+		Suspended:    types.BoolValue(u.Suspended),
+		IsAdmin:      types.BoolValue(u.IsAdmin),
+		CreationTime: types.StringValue(u.CreationTime),
+	}
+
+	if u.Name != nil {
+		user.GivenName = types.StringValue(u.Name.GivenName)
+		user.FamilyName = types.StringValue(u.Name.FamilyName)
+	}
+
+	return user
+}
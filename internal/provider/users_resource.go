@@ -0,0 +1,519 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UsersResource{}
+var _ resource.ResourceWithImportState = &UsersResource{}
+
+func NewUsersResource() resource.Resource {
+	return &UsersResource{}
+}
+
+// UsersResource defines the resource implementation. It authoritatively
+// manages the users placed directly in a single org unit.
+type UsersResource struct {
+	client     *http.Client
+	customerId string
+
+	adminService *admin.Service
+}
+
+// UsersResourceModel describes the resource data model.
+type UsersResourceModel struct {
+	OrgUnitPath     types.String             `tfsdk:"org_unit_path"`
+	InitialPassword types.String             `tfsdk:"initial_password"`
+	AllowDeletions  types.Bool               `tfsdk:"allow_deletions"`
+	Users           []UsersResourceUserModel `tfsdk:"users"`
+	Id              types.String             `tfsdk:"id"`
+}
+
+// Nested Model for "users".
+type UsersResourceUserModel struct {
+	PrimaryEmail types.String `tfsdk:"primary_email"`
+	GivenName    types.String `tfsdk:"given_name"`
+	FamilyName   types.String `tfsdk:"family_name"`
+	Suspended    types.Bool   `tfsdk:"suspended"`
+}
+
+func (r *UsersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (r *UsersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Authoritatively manages the users placed directly in an org unit.
+		Users in the set are created or updated, users missing from the set are only deleted
+		when 'allow_deletions' is enabled. Users that exist in another org unit are not moved
+		into this one and fail the apply.`,
+
+		Attributes: map[string]schema.Attribute{
+			"org_unit_path": schema.StringAttribute{
+				MarkdownDescription: "Full path of the org unit whose users are managed, e.g. '/Engineering'",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"initial_password": schema.StringAttribute{
+				MarkdownDescription: `Password given to newly created users. Users must change it at
+				their next login. Required when the set contains users that do not exist yet. This
+				is a write-only attribute: it is never stored in state.`,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"allow_deletions": schema.BoolAttribute{
+				MarkdownDescription: `Delete users that are placed in the org unit but missing from
+				'users', and delete all managed users when this resource is destroyed. Defaults to false.`,
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"users": schema.SetNestedAttribute{
+				MarkdownDescription: "The users that should be placed in the org unit",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"primary_email": schema.StringAttribute{
+							MarkdownDescription: "Primary email address of the user",
+							Required:            true,
+						},
+						"given_name": schema.StringAttribute{
+							MarkdownDescription: "First name of the user",
+							Required:            true,
+						},
+						"family_name": schema.StringAttribute{
+							MarkdownDescription: "Last name of the user",
+							Required:            true,
+						},
+						"suspended": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is suspended. Defaults to false.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The org unit path",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UsersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pd.client
+	r.customerId = pd.customerId
+	r.adminService = pd.adminService
+}
+
+func (r *UsersResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data UsersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only attributes are only available in the configuration.
+	var initialPassword types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initial_password"), &initialPassword)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ok := r.reconcile(ctx, &data, initialPassword, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data.Id = data.OrgUnitPath
+
+	// Save data into Terraform state, also when some users failed so that
+	// Terraform keeps track of the users that were reconciled.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsersResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data UsersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.listOrgUnitUsers(ctx, data.OrgUnitPath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list users in org unit '%s', got error: %s", data.OrgUnitPath.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	if data.AllowDeletions.IsNull() {
+		data.AllowDeletions = types.BoolValue(false)
+	}
+
+	// State written before initial_password was write-only may still hold it.
+	data.InitialPassword = types.StringNull()
+
+	// Keep the casing of the configured emails so that Google's lowercasing
+	// does not show up as a diff.
+	managed := map[string]types.String{}
+	for _, u := range data.Users {
+		managed[canonicalKey(u.PrimaryEmail.ValueString())] = u.PrimaryEmail
+	}
+
+	keys := make([]string, 0, len(current))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// Users is nil right after an import, in which case every user in the org
+	// unit is adopted.
+	adoptAll := data.AllowDeletions.ValueBool() || data.Users == nil
+
+	users := []UsersResourceUserModel{}
+	for _, key := range keys {
+		email, ok := managed[key]
+		if !ok {
+			if !adoptAll {
+				continue
+			}
+			email = types.StringValue(current[key].PrimaryEmail)
+		}
+		users = append(users, usersResourceUserFromAPI(email, current[key]))
+	}
+	data.Users = users
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsersResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data UsersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var initialPassword types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initial_password"), &initialPassword)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ok := r.reconcile(ctx, &data, initialPassword, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Save updated data into Terraform state, also when some users failed
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsersResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data UsersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AllowDeletions.ValueBool() {
+		tflog.Warn(ctx, "allow_deletions is disabled, leaving users in Google Workspace", map[string]interface{}{
+			"org_unit_path": data.OrgUnitPath.ValueString(),
+			"count":         len(data.Users),
+		})
+		return
+	}
+
+	// Only users still placed in the org unit are deleted, state may hold
+	// users whose creation failed and that exist elsewhere.
+	current, err := r.listOrgUnitUsers(ctx, data.OrgUnitPath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list users in org unit '%s', got error: %s", data.OrgUnitPath.ValueString(), formatAPIError(err)),
+		)
+		return
+	}
+
+	for _, u := range data.Users {
+		existing, ok := current[canonicalKey(u.PrimaryEmail.ValueString())]
+		if !ok {
+			continue
+		}
+
+		email := existing.PrimaryEmail
+		err := r.adminService.Users.Delete(existing.Id).Context(ctx).Do()
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Google User",
+				fmt.Sprintf("Could not delete user %s: %v", email, formatAPIError(err)),
+			)
+		}
+	}
+}
+
+func (r *UsersResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_unit_path"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// reconcile creates, updates and (when allowed) deletes users so the org unit
+// matches data.Users, creating new users with initialPassword. Failures are
+// added to diags per user and do not stop the remaining users from being
+// reconciled. data.Users is replaced with the users as they are in Google;
+// users that failed keep their current or else desired values, Read drops
+// those not in the org unit. It returns false when the org unit could not be
+// listed and nothing was changed.
+func (r *UsersResource) reconcile(ctx context.Context, data *UsersResourceModel, initialPassword types.String, diags *diag.Diagnostics) bool {
+	orgUnitPath := data.OrgUnitPath.ValueString()
+
+	current, err := r.listOrgUnitUsers(ctx, orgUnitPath)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list users in org unit '%s', got error: %s", orgUnitPath, formatAPIError(err)),
+		)
+		return false
+	}
+
+	desired := map[string]bool{}
+	users := []UsersResourceUserModel{}
+
+	for _, u := range data.Users {
+		key := canonicalKey(u.PrimaryEmail.ValueString())
+		desired[key] = true
+
+		res, err := r.applyUser(ctx, orgUnitPath, initialPassword, u, current[key])
+		if err != nil {
+			diags.AddError(
+				"Error Reconciling Google User",
+				fmt.Sprintf("Could not reconcile user %s: %v", u.PrimaryEmail.ValueString(), formatAPIError(err)),
+			)
+			if current[key] != nil {
+				u = usersResourceUserFromAPI(u.PrimaryEmail, current[key])
+			}
+			users = append(users, u)
+			continue
+		}
+
+		users = append(users, usersResourceUserFromAPI(u.PrimaryEmail, res))
+	}
+
+	if data.AllowDeletions.ValueBool() {
+		for key, u := range current {
+			if desired[key] {
+				continue
+			}
+
+			err := r.adminService.Users.Delete(u.Id).Context(ctx).Do()
+			if err != nil && !isNotFound(err) {
+				diags.AddError(
+					"Error Deleting Google User",
+					fmt.Sprintf("Could not delete user %s: %v", u.PrimaryEmail, formatAPIError(err)),
+				)
+				// Keep the user in state so the deletion is retried.
+				users = append(users, usersResourceUserFromAPI(types.StringValue(u.PrimaryEmail), u))
+				continue
+			}
+
+			tflog.Trace(ctx, "Deleted Google User", map[string]interface{}{
+				"id":    u.Id,
+				"email": u.PrimaryEmail,
+			})
+		}
+	}
+
+	data.Users = users
+
+	return true
+}
+
+// applyUser brings a single user in line with the desired model, creating it
+// when it does not exist anywhere in the directory.
+func (r *UsersResource) applyUser(
+	ctx context.Context,
+	orgUnitPath string,
+	initialPassword types.String,
+	desired UsersResourceUserModel,
+	current *admin.User,
+) (*admin.User, error) {
+	email := desired.PrimaryEmail.ValueString()
+
+	user := &admin.User{
+		PrimaryEmail: email,
+		Name: &admin.UserName{
+			GivenName:  desired.GivenName.ValueString(),
+			FamilyName: desired.FamilyName.ValueString(),
+		},
+		OrgUnitPath:     orgUnitPath,
+		Suspended:       desired.Suspended.ValueBool(),
+		ForceSendFields: []string{"Suspended"},
+	}
+
+	if current != nil {
+		if usersResourceUserMatches(desired, current) {
+			return current, nil
+		}
+		return r.adminService.Users.Update(current.Id, user).Context(ctx).Do()
+	}
+
+	// A user in another org unit may be managed elsewhere, e.g. by another
+	// googleworkspace_users resource. It is not moved, as the two would move
+	// it back and forth on every apply.
+	existing, err := r.adminService.Users.Get(email).Context(ctx).Do()
+	if err == nil {
+		return nil, fmt.Errorf("user already exists in org unit %s, move it to %s outside of Terraform to manage it here", existing.OrgUnitPath, orgUnitPath)
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+
+	if initialPassword.ValueString() == "" {
+		return nil, errors.New("initial_password must be set to create new users")
+	}
+
+	user.Password = initialPassword.ValueString()
+	user.ChangePasswordAtNextLogin = true
+
+	res, err := r.adminService.Users.Insert(user).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Trace(ctx, "Created Google User", map[string]interface{}{
+		"id":    res.Id,
+		"email": res.PrimaryEmail,
+	})
+
+	return res, nil
+}
+
+// listOrgUnitUsers returns the users placed directly in orgUnitPath, keyed by
+// their canonical primary email.
+func (r *UsersResource) listOrgUnitUsers(ctx context.Context, orgUnitPath string) (map[string]*admin.User, error) {
+	users := map[string]*admin.User{}
+
+	query, err := directoryQuery("orgUnitPath", "=", orgUnitPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.adminService.Users.List().Customer(r.customerId).Query(query).Pages(ctx, func(page *admin.Users) error {
+		for _, u := range page.Users {
+			// The query also matches users in child org units.
+			if !strings.EqualFold(u.OrgUnitPath, orgUnitPath) {
+				continue
+			}
+			users[canonicalKey(u.PrimaryEmail)] = u
+		}
+		return nil
+	})
+
+	return users, err
+}
+
+func usersResourceUserMatches(desired UsersResourceUserModel, current *admin.User) bool {
+	if current.Name == nil {
+		return false
+	}
+
+	return current.Name.GivenName == desired.GivenName.ValueString() &&
+		current.Name.FamilyName == desired.FamilyName.ValueString() &&
+		current.Suspended == desired.Suspended.ValueBool()
+}
+
+func usersResourceUserFromAPI(email types.String, u *admin.User) UsersResourceUserModel {
+	user := UsersResourceUserModel{
+		PrimaryEmail: email,
+		GivenName:    types.StringValue(""),
+		FamilyName:   types.StringValue(""),
+		Suspended:    types.BoolValue(u.Suspended),
+	}
+
+	if u.Name != nil {
+		user.GivenName = types.StringValue(u.Name.GivenName)
+		user.FamilyName = types.StringValue(u.Name.FamilyName)
+	}
+
+	return user
+}
@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// testUsersResourceUser returns a user of the users resource.
+func testUsersResourceUser(email, familyName string, suspended bool) UsersResourceUserModel {
+	return UsersResourceUserModel{
+		PrimaryEmail: types.StringValue(email),
+		GivenName:    types.StringValue("John"),
+		FamilyName:   types.StringValue(familyName),
+		Suspended:    types.BoolValue(suspended),
+	}
+}
+
+// testUsersResourceHandler serves the Directory API for users, the users in
+// the org unit are listed and looked up by email, other users are only looked
+// up. Updates of users with an id in failUpdates fail.
+func testUsersResourceHandler(t *testing.T, orgUnit, other []*admin.User, failUpdates map[string]bool, requests *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		key := strings.TrimPrefix(r.URL.Path, "/admin/directory/v1/users/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/directory/v1/users":
+			_ = json.NewEncoder(w).Encode(&admin.Users{Users: orgUnit})
+		case r.Method == http.MethodGet:
+			for _, u := range append(orgUnit, other...) {
+				if u.PrimaryEmail == key {
+					_ = json.NewEncoder(w).Encode(u)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Resource Not Found: userKey"}}`))
+		case r.Method == http.MethodPut && failUpdates[key]:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code":400,"message":"Invalid Input"}}`))
+		case r.Method == http.MethodPut, r.Method == http.MethodPost:
+			var u admin.User
+			if err := json.NewDecoder(r.Body).Decode(&u); err != nil {
+				t.Errorf("unexpected error decoding the user: %s", err)
+			}
+			if r.Method == http.MethodPost {
+				if u.Password != "Initial-1" || !u.ChangePasswordAtNextLogin {
+					t.Errorf("got password %q and change at next login %t, want the initial password to be changed", u.Password, u.ChangePasswordAtNextLogin)
+				}
+				u.Id = "new"
+				u.Password = ""
+			} else {
+				u.Id = key
+			}
+			_ = json.NewEncoder(w).Encode(&u)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func TestUsersResourceUpdateMixed(t *testing.T) {
+	ctx := context.Background()
+
+	orgUnit := []*admin.User{
+		{Id: "1", PrimaryEmail: "unchanged@example.com", OrgUnitPath: "/Engineering", Name: &admin.UserName{GivenName: "John", FamilyName: "Doe"}},
+		{Id: "2", PrimaryEmail: "renamed@example.com", OrgUnitPath: "/Engineering", Name: &admin.UserName{GivenName: "John", FamilyName: "Doe"}},
+		{Id: "3", PrimaryEmail: "broken@example.com", OrgUnitPath: "/Engineering", Name: &admin.UserName{GivenName: "John", FamilyName: "Doe"}},
+	}
+	other := []*admin.User{
+		{Id: "4", PrimaryEmail: "other@example.com", OrgUnitPath: "/Sales", Name: &admin.UserName{GivenName: "John", FamilyName: "Doe"}},
+	}
+
+	var requests []string
+	r := &UsersResource{
+		adminService: newTestAdminService(t, testUsersResourceHandler(t, orgUnit, other, map[string]bool{"3": true}, &requests)),
+		customerId:   "C01",
+	}
+
+	data := UsersResourceModel{
+		OrgUnitPath:     types.StringValue("/Engineering"),
+		InitialPassword: types.StringNull(),
+		AllowDeletions:  types.BoolValue(false),
+		Users: []UsersResourceUserModel{
+			testUsersResourceUser("unchanged@example.com", "Doe", false),
+			testUsersResourceUser("renamed@example.com", "Smith", false),
+			testUsersResourceUser("broken@example.com", "Doe", true),
+			testUsersResourceUser("new@example.com", "Doe", false),
+			testUsersResourceUser("other@example.com", "Doe", false),
+		},
+		Id: types.StringValue("/Engineering"),
+	}
+	config := data
+	config.InitialPassword = types.StringValue("Initial-1")
+	config.Id = types.StringNull()
+
+	state := newTestResourceState(t, r, data)
+	req := resource.UpdateRequest{
+		Plan:   newTestResourcePlan(t, r, data),
+		Config: newTestResourceConfig(t, r, config),
+		State:  state,
+	}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, req, resp)
+
+	if got := resp.Diagnostics.ErrorsCount(); got != 2 {
+		t.Fatalf("got %d errors, want one for the failed update and one for the user in another org unit: %v", got, resp.Diagnostics)
+	}
+	for _, email := range []string{"broken@example.com", "other@example.com"} {
+		found := false
+		for _, d := range resp.Diagnostics.Errors() {
+			found = found || strings.Contains(d.Detail(), email)
+		}
+		if !found {
+			t.Errorf("got diagnostics %v, want an error for %s", resp.Diagnostics, email)
+		}
+	}
+
+	wantRequests := map[string]int{
+		"PUT /admin/directory/v1/users/1": 0,
+		"PUT /admin/directory/v1/users/2": 1,
+		"PUT /admin/directory/v1/users/3": 1,
+		"PUT /admin/directory/v1/users/4": 0,
+		"POST /admin/directory/v1/users":  1,
+	}
+	for request, want := range wantRequests {
+		got := 0
+		for _, sent := range requests {
+			if sent == request {
+				got++
+			}
+		}
+		if got != want {
+			t.Errorf("got %d requests %s, want %d", got, request, want)
+		}
+	}
+
+	var got UsersResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if !got.InitialPassword.IsNull() {
+		t.Errorf("got initial_password %s in state, want null", got.InitialPassword)
+	}
+
+	want := map[string]UsersResourceUserModel{
+		"unchanged@example.com": testUsersResourceUser("unchanged@example.com", "Doe", false),
+		"renamed@example.com":   testUsersResourceUser("renamed@example.com", "Smith", false),
+		// Failed users are kept in state, as they are in Google.
+		"broken@example.com": testUsersResourceUser("broken@example.com", "Doe", false),
+		"new@example.com":    testUsersResourceUser("new@example.com", "Doe", false),
+		"other@example.com":  testUsersResourceUser("other@example.com", "Doe", false),
+	}
+	if len(got.Users) != len(want) {
+		t.Fatalf("got %d users in state, want %d", len(got.Users), len(want))
+	}
+	for _, u := range got.Users {
+		if u != want[u.PrimaryEmail.ValueString()] {
+			t.Errorf("got user %+v, want %+v", u, want[u.PrimaryEmail.ValueString()])
+		}
+	}
+}
+
+func TestUsersResourceCreateWithoutInitialPassword(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	r := &UsersResource{
+		adminService: newTestAdminService(t, testUsersResourceHandler(t, nil, nil, nil, &requests)),
+		customerId:   "C01",
+	}
+
+	data := UsersResourceModel{
+		OrgUnitPath:     types.StringValue("/Engineering"),
+		InitialPassword: types.StringNull(),
+		AllowDeletions:  types.BoolValue(false),
+		Users:           []UsersResourceUserModel{testUsersResourceUser("new@example.com", "Doe", false)},
+		Id:              types.StringNull(),
+	}
+
+	req := resource.CreateRequest{
+		Plan:   newTestResourcePlan(t, r, data),
+		Config: newTestResourceConfig(t, r, data),
+	}
+	resp := &resource.CreateResponse{State: newTestResourceState(t, r, data)}
+	r.Create(ctx, req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("got no error, want initial_password to be required")
+	}
+	for _, request := range requests {
+		if strings.HasPrefix(request, http.MethodPost) {
+			t.Errorf("got request %s, want no user to be created", request)
+		}
+	}
+}
+
+func TestUsersResourceDelete(t *testing.T) {
+	orgUnit := []*admin.User{
+		{Id: "1", PrimaryEmail: "jdoe@example.com", OrgUnitPath: "/Engineering"},
+	}
+
+	var requests []string
+	r := &UsersResource{
+		adminService: newTestAdminService(t, testUsersResourceHandler(t, orgUnit, nil, nil, &requests)),
+		customerId:   "C01",
+	}
+
+	data := UsersResourceModel{
+		OrgUnitPath:     types.StringValue("/Engineering"),
+		InitialPassword: types.StringNull(),
+		AllowDeletions:  types.BoolValue(true),
+		Users: []UsersResourceUserModel{
+			testUsersResourceUser("jdoe@example.com", "Doe", false),
+			// Failed to be created as it exists in another org unit.
+			testUsersResourceUser("other@example.com", "Doe", false),
+		},
+		Id: types.StringValue("/Engineering"),
+	}
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{State: newTestResourceState(t, r, data)}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var deleted []string
+	for _, request := range requests {
+		if strings.HasPrefix(request, http.MethodDelete) {
+			deleted = append(deleted, request)
+		}
+	}
+	if len(deleted) != 1 || deleted[0] != "DELETE /admin/directory/v1/users/1" {
+		t.Errorf("got deletes %v, want only the user in the org unit", deleted)
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure validators fully satisfy framework interfaces.
+var _ validator.String = stringLengthAtMostValidator{}
+var _ validator.String = stringOneOfValidator{}
+var _ validator.Set = setValuesOneOfValidator{}
+var _ validator.String = stringIsJSONValidator{}
+var _ validator.Int64 = int64BetweenValidator{}
+var _ validator.Set = setObjectsUniqueKeyValidator{}
+var _ validator.String = stringIsTimeValidator{}
+var _ validator.String = stringNotBlankValidator{}
+
+// stringLengthAtMostValidator validates that a string attribute holds at most
+// maxLength characters.
+type stringLengthAtMostValidator struct {
+	maxLength int
+}
+
+// stringLengthAtMost returns a validator that rejects strings longer than
+// maxLength characters at plan time.
+func stringLengthAtMost(maxLength int) validator.String {
+	return stringLengthAtMostValidator{maxLength: maxLength}
+}
+
+func (v stringLengthAtMostValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("string length must be at most %d characters", v.maxLength)
+}
+
+func (v stringLengthAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringLengthAtMostValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	length := utf8.RuneCountInString(req.ConfigValue.ValueString())
+	if length > v.maxLength {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Length",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), length),
+		)
+	}
+}
+
+// stringOneOfValidator validates that a string attribute is one of a fixed set
+// of values, typically an API enum.
+type stringOneOfValidator struct {
+	values []string
+}
+
+// stringOneOf returns a validator that rejects strings not in values at plan
+// time.
+func stringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+	)
+}
+
+// setValuesOneOfValidator validates that every element of a set of strings is
+// one of a fixed set of values.
+type setValuesOneOfValidator struct {
+	values []string
+}
+
+// setValuesOneOf returns a validator that rejects sets containing strings not
+// in values at plan time.
+func setValuesOneOf(values ...string) validator.Set {
+	return setValuesOneOfValidator{values: values}
+}
+
+func (v setValuesOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("values must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v setValuesOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v setValuesOneOfValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		elementValidator := stringOneOfValidator{values: v.values}
+		elementResp := &validator.StringResponse{}
+		elementValidator.ValidateString(ctx, validator.StringRequest{
+			Path:        req.Path.AtSetValue(value),
+			ConfigValue: value,
+		}, elementResp)
+		resp.Diagnostics.Append(elementResp.Diagnostics...)
+	}
+}
+
+// stringIsJSONValidator validates that a string attribute holds well-formed
+// JSON.
+type stringIsJSONValidator struct{}
+
+// stringIsJSON returns a validator that rejects malformed JSON at plan time.
+func stringIsJSON() validator.String {
+	return stringIsJSONValidator{}
+}
+
+func (v stringIsJSONValidator) Description(ctx context.Context) string {
+	return "value must be well-formed JSON"
+}
+
+func (v stringIsJSONValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringIsJSONValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			fmt.Sprintf("Attribute %s %s, got error: %s", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+// int64BetweenValidator validates that an int64 attribute is within an
+// inclusive range.
+type int64BetweenValidator struct {
+	min, max int64
+}
+
+// int64Between returns a validator that rejects integers outside of min and
+// max at plan time.
+func int64Between(min, max int64) validator.Int64 {
+	return int64BetweenValidator{min: min, max: max}
+}
+
+func (v int64BetweenValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64BetweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// setObjectsUniqueKeyValidator validates that no two objects of a set share
+// the same value of a string attribute once canonicalized, e.g. the same
+// email address in different casing.
+type setObjectsUniqueKeyValidator struct {
+	attribute string
+}
+
+// setObjectsUniqueKey returns a validator that rejects sets of objects holding
+// the same key in attribute more than once at plan time.
+func setObjectsUniqueKey(attribute string) validator.Set {
+	return setObjectsUniqueKeyValidator{attribute: attribute}
+}
+
+func (v setObjectsUniqueKeyValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s must be unique, ignoring case", v.attribute)
+}
+
+func (v setObjectsUniqueKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v setObjectsUniqueKeyValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, element := range req.ConfigValue.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		value, ok := object.Attributes()[v.attribute].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		key := canonicalKey(value.ValueString())
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(object),
+				"Duplicate Attribute Value",
+				fmt.Sprintf("Attribute %s %s, got %q more than once", req.Path, v.Description(ctx), value.ValueString()),
+			)
+			continue
+		}
+		seen[key] = true
+	}
+}
+
+// stringIsTimeValidator validates that a string attribute holds a time in the
+// given layout.
+type stringIsTimeValidator struct {
+	layout string
+}
+
+// stringIsTime returns a validator that rejects strings which can't be parsed
+// as a time in layout at plan time.
+func stringIsTime(layout string) validator.String {
+	return stringIsTimeValidator{layout: layout}
+}
+
+func (v stringIsTimeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a time in the format %s", v.layout)
+}
+
+func (v stringIsTimeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringIsTimeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(v.layout, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got error: %s", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+// stringNotBlankValidator validates that a string attribute holds more than
+// whitespace.
+type stringNotBlankValidator struct{}
+
+// stringNotBlank returns a validator that rejects empty and whitespace-only
+// strings at plan time.
+func stringNotBlank() validator.String {
+	return stringNotBlankValidator{}
+}
+
+func (v stringNotBlankValidator) Description(ctx context.Context) string {
+	return "value must not be empty"
+}
+
+func (v stringNotBlankValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringNotBlankValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if strings.TrimSpace(req.ConfigValue.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s", req.Path, v.Description(ctx)),
+		)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStringLengthAtMost(t *testing.T) {
+	tests := map[string]struct {
+		maxLength int
+		value     types.String
+		wantErr   bool
+	}{
+		"null": {
+			maxLength: 3,
+			value:     types.StringNull(),
+		},
+		"unknown": {
+			maxLength: 3,
+			value:     types.StringUnknown(),
+		},
+		"empty": {
+			maxLength: 3,
+			value:     types.StringValue(""),
+		},
+		"at most": {
+			maxLength: 3,
+			value:     types.StringValue("abc"),
+		},
+		"too long": {
+			maxLength: 3,
+			value:     types.StringValue("abcd"),
+			wantErr:   true,
+		},
+		"characters are counted, not bytes": {
+			maxLength: 3,
+			value:     types.StringValue("été"),
+		},
+		"group name at most": {
+			maxLength: groupNameMaxLength,
+			value:     types.StringValue(strings.Repeat("a", groupNameMaxLength)),
+		},
+		"group name too long": {
+			maxLength: groupNameMaxLength,
+			value:     types.StringValue(strings.Repeat("a", groupNameMaxLength+1)),
+			wantErr:   true,
+		},
+		"group description at most": {
+			maxLength: groupDescriptionMaxLength,
+			value:     types.StringValue(strings.Repeat("é", groupDescriptionMaxLength)),
+		},
+		"group description too long": {
+			maxLength: groupDescriptionMaxLength,
+			value:     types.StringValue(strings.Repeat("a", groupDescriptionMaxLength+1)),
+			wantErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			stringLengthAtMost(test.maxLength).ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("name"),
+				ConfigValue: test.value,
+			}, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Errorf("got diagnostics %v, want error %t", resp.Diagnostics, test.wantErr)
+			}
+		})
+	}
+}
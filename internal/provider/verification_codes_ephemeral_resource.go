@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &VerificationCodesEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &VerificationCodesEphemeralResource{}
+
+func NewVerificationCodesEphemeralResource() ephemeral.EphemeralResource {
+	return &VerificationCodesEphemeralResource{}
+}
+
+// VerificationCodesEphemeralResource defines the ephemeral resource implementation.
+type VerificationCodesEphemeralResource struct {
+	adminService *admin.Service
+}
+
+// VerificationCodesEphemeralResourceModel describes the ephemeral resource data model.
+type VerificationCodesEphemeralResourceModel struct {
+	UserKey types.String   `tfsdk:"user_key"`
+	Codes   []types.String `tfsdk:"codes"`
+}
+
+func (r *VerificationCodesEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verification_codes"
+}
+
+func (r *VerificationCodesEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Backup verification codes of a user for 2-Step Verification, e.g. to
+		recover an account. Every time the ephemeral resource is opened, which happens during
+		both plan and apply, a new set of codes is generated and the previous codes stop
+		working. The codes are never stored in the plan or state.` + scopesDescription(admin.AdminDirectoryUserSecurityScope),
+
+		Attributes: map[string]schema.Attribute{
+			"user_key": schema.StringAttribute{
+				MarkdownDescription: "The user's primary email address, alias email address, or unique user ID",
+				Required:            true,
+			},
+			"codes": schema.ListAttribute{
+				MarkdownDescription: "The generated verification codes",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *VerificationCodesEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ephemeralResourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ephemeralResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.adminService = data.adminService
+}
+
+func (r *VerificationCodesEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data VerificationCodesEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userKey := data.UserKey.ValueString()
+
+	err := r.adminService.VerificationCodes.Generate(userKey).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"User Not Found",
+				fmt.Sprintf("User %s does not exist in Google Workspace.", userKey),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Generating Verification Codes",
+			fmt.Sprintf("Could not generate verification codes for user %s: %v", userKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	// Generate does not return the codes, they have to be listed.
+	res, err := r.adminService.VerificationCodes.List(userKey).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list verification codes of user '%s', got error: %s", userKey, formatAPIError(err)),
+		)
+		return
+	}
+
+	data.Codes = make([]types.String, 0, len(res.Items))
+	for _, c := range res.Items {
+		data.Codes = append(data.Codes, types.StringValue(c.VerificationCode))
+	}
+
+	tflog.Trace(ctx, "Generated verification codes", map[string]interface{}{
+		"user_key": userKey,
+		"count":    len(data.Codes),
+	})
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
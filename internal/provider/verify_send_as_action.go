@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/api/gmail/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &VerifySendAsAction{}
+var _ action.ActionWithConfigure = &VerifySendAsAction{}
+
+func NewVerifySendAsAction() action.Action {
+	return &VerifySendAsAction{}
+}
+
+// VerifySendAsAction defines the action implementation.
+type VerifySendAsAction struct {
+	providerData *providerData
+}
+
+// VerifySendAsActionModel describes the action data model.
+type VerifySendAsActionModel struct {
+	UserId      types.String `tfsdk:"user_id"`
+	SendAsEmail types.String `tfsdk:"send_as_email"`
+}
+
+func (a *VerifySendAsAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verify_send_as"
+}
+
+func (a *VerifySendAsAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Sends a verification email to the address of a send-as alias that is
+		pending verification. The alias can be used once the link in the email has been
+		followed, which googleworkspace_gmail_send_as reports as verification_status
+		'accepted'.`,
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Primary email address of the user the alias belongs to",
+				Required:            true,
+			},
+			"send_as_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the send-as alias",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *VerifySendAsAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.providerData = pd
+}
+
+func (a *VerifySendAsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data VerifySendAsActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userId := data.UserId.ValueString()
+	sendAsEmail := data.SendAsEmail.ValueString()
+
+	srv := a.providerData.gmailService(ctx, userId, gmail.GmailSettingsSharingScope, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sendAs, err := srv.Users.Settings.SendAs.Get(userId, sendAsEmail).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Send-As Alias Not Found",
+				fmt.Sprintf("User %s has no send-as alias %s.", userId, sendAsEmail),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read send-as alias '%s' of user '%s', got error: %s", sendAsEmail, userId, formatAPIError(err)),
+		)
+		return
+	}
+
+	// Only aliases of external addresses need verification, those of the
+	// user's own addresses have no verification status at all.
+	if sendAs.VerificationStatus != "pending" {
+		status := sendAs.VerificationStatus
+		if status == "" {
+			status = "not applicable"
+		}
+		resp.Diagnostics.AddError(
+			"Send-As Alias Not Pending",
+			fmt.Sprintf("Send-as alias %s of user %s cannot be verified, its verification status is %s.", sendAsEmail, userId, status),
+		)
+		return
+	}
+
+	err = srv.Users.Settings.SendAs.Verify(userId, sendAsEmail).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Verifying Send-As Alias",
+			fmt.Sprintf("Could not send a verification email for send-as alias %s of user %s: %v", sendAsEmail, userId, formatAPIError(err)),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Sent send-as verification email", map[string]interface{}{
+		"user_id":       userId,
+		"send_as_email": sendAsEmail,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sent a verification email to %s", sendAsEmail),
+	})
+}